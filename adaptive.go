@@ -0,0 +1,105 @@
+// adaptive.go: Load-adaptive resolution for ModeAdaptive
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Default resolution bounds of ModeAdaptive.
+const (
+	// DefaultAdaptiveMin is the finest resolution ModeAdaptive tightens to by default.
+	DefaultAdaptiveMin = 100 * time.Microsecond
+
+	// DefaultAdaptiveMax is the coarsest resolution ModeAdaptive relaxes to by default.
+	DefaultAdaptiveMax = 10 * time.Millisecond
+)
+
+// adaptSteps is the number of consecutive ticks with, or without, reads
+// required before the resolution is halved, or doubled. It damps
+// oscillation around the read interval.
+const adaptSteps = 8
+
+// WithAdaptiveBounds sets the resolution range of a ModeAdaptive cache.
+// Both bounds must be within [MinResolution, MaxResolution] and min must not
+// exceed max. It has no effect in other modes. The defaults are
+// DefaultAdaptiveMin and DefaultAdaptiveMax.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(
+//		timecache.WithMode(timecache.ModeAdaptive),
+//		timecache.WithAdaptiveBounds(50*time.Microsecond, 5*time.Millisecond),
+//	)
+func WithAdaptiveBounds(min, max time.Duration) Option {
+	return func(c *config) error {
+		if err := validateResolution(min); err != nil {
+			return fmt.Errorf("adaptive minimum: %w", err)
+		}
+		if err := validateResolution(max); err != nil {
+			return fmt.Errorf("adaptive maximum: %w", err)
+		}
+		if min > max {
+			return fmt.Errorf("%w: adaptive minimum %v exceeds maximum %v", ErrInvalidResolution, min, max)
+		}
+		c.adaptiveMin = min
+		c.adaptiveMax = max
+		return nil
+	}
+}
+
+// clampResolution returns resolution limited to [min, max].
+func clampResolution(resolution, min, max time.Duration) time.Duration {
+	switch {
+	case resolution < min:
+		return min
+	case resolution > max:
+		return max
+	}
+	return resolution
+}
+
+// adaptTick accounts for one tick of the updater and adjusts the resolution
+// once adaptSteps consecutive ticks agree: halving it while every tick sees
+// reads and doubling it while none do.
+func (tc *TimeCache) adaptTick(ticker *time.Ticker, streak *int) {
+	if atomic.SwapUint32(&tc.reads, 0) == 1 {
+		if *streak < 0 {
+			*streak = 0
+		}
+		*streak++
+	} else {
+		if *streak > 0 {
+			*streak = 0
+		}
+		*streak--
+	}
+
+	if *streak > -adaptSteps && *streak < adaptSteps {
+		return
+	}
+	faster := *streak > 0
+	*streak = 0
+
+	// Hold mu for the read-modify-write so a concurrent SetResolution is
+	// never overwritten by a step computed from the previous value.
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	current := tc.Resolution()
+	next := current * 2
+	if faster {
+		next = current / 2
+	}
+	next = clampResolution(next, tc.adaptiveMin, tc.adaptiveMax)
+	if next != current {
+		atomic.StoreInt64(&tc.resolution, int64(next))
+		ticker.Reset(next)
+	}
+}
@@ -0,0 +1,169 @@
+// adaptive_test.go: Test suite for load-adaptive resolution
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestWithAdaptiveBoundsValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max time.Duration
+	}{
+		{"minimum too small", 0, time.Millisecond},
+		{"maximum too large", time.Millisecond, MaxResolution + 1},
+		{"inverted bounds", time.Millisecond, 100 * time.Microsecond},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewWithOptions(WithAdaptiveBounds(tt.min, tt.max)); !errors.Is(err, ErrInvalidResolution) {
+			t.Errorf("%s: error mismatch: got %v", tt.name, err)
+		}
+	}
+}
+
+func TestModeAdaptiveInitialResolutionClamped(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeAdaptive),
+		WithResolution(time.Second),
+		WithAdaptiveBounds(time.Millisecond, 20*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Resolution() != 20*time.Millisecond {
+		t.Errorf("Initial resolution not clamped: got %v", tc.Resolution())
+	}
+}
+
+func TestModeAdaptiveRelaxesWhenIdle(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeAdaptive),
+		WithResolution(100*time.Microsecond),
+		WithAdaptiveBounds(100*time.Microsecond, 800*time.Microsecond),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// Without reads the resolution must relax up to the maximum
+	deadline := time.Now().Add(2 * time.Second)
+	for tc.Resolution() != 800*time.Microsecond && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if tc.Resolution() != 800*time.Microsecond {
+		t.Errorf("Resolution did not relax to the maximum: got %v", tc.Resolution())
+	}
+}
+
+func TestModeAdaptiveTightensUnderLoad(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeAdaptive),
+		WithResolution(800*time.Microsecond),
+		WithAdaptiveBounds(100*time.Microsecond, 800*time.Microsecond),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// Continuous reads must tighten the resolution down to the minimum.
+	// The reader yields so the updater keeps ticking with GOMAXPROCS=1.
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = tc.CachedTimeNano()
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for tc.Resolution() != 100*time.Microsecond && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(stop)
+	<-done
+
+	if tc.Resolution() != 100*time.Microsecond {
+		t.Errorf("Resolution did not tighten to the minimum: got %v", tc.Resolution())
+	}
+}
+
+func TestAdaptTickHysteresis(t *testing.T) {
+	tc := &TimeCache{
+		resolution:  int64(time.Millisecond),
+		adaptiveMin: 100 * time.Microsecond,
+		adaptiveMax: 10 * time.Millisecond,
+	}
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	streak := 0
+	for i := 0; i < adaptSteps-1; i++ {
+		tc.markRead()
+		tc.adaptTick(ticker, &streak)
+	}
+	if tc.Resolution() != time.Millisecond {
+		t.Fatalf("Resolution changed before %d ticks with reads: got %v", adaptSteps, tc.Resolution())
+	}
+
+	// A tick without reads resets the streak
+	tc.adaptTick(ticker, &streak)
+	tc.markRead()
+	tc.adaptTick(ticker, &streak)
+	if tc.Resolution() != time.Millisecond {
+		t.Fatalf("Resolution changed after a broken streak: got %v", tc.Resolution())
+	}
+
+	for i := 0; i < adaptSteps; i++ {
+		tc.markRead()
+		tc.adaptTick(ticker, &streak)
+	}
+	if tc.Resolution() != 500*time.Microsecond {
+		t.Errorf("Resolution not halved after %d ticks with reads: got %v", adaptSteps, tc.Resolution())
+	}
+}
+
+func TestAdaptTickKeepsSetResolution(t *testing.T) {
+	tc := &TimeCache{
+		resolution:  int64(time.Millisecond),
+		adaptiveMin: 100 * time.Microsecond,
+		adaptiveMax: 10 * time.Millisecond,
+	}
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	streak := 0
+	for i := 0; i < adaptSteps-1; i++ {
+		tc.markRead()
+		tc.adaptTick(ticker, &streak)
+	}
+
+	// A step due right after SetResolution starts from the user's value
+	if err := tc.SetResolution(4 * time.Millisecond); err != nil {
+		t.Fatalf("SetResolution returned error: %v", err)
+	}
+	tc.markRead()
+	tc.adaptTick(ticker, &streak)
+	if got := tc.Resolution(); got != 2*time.Millisecond {
+		t.Errorf("Adaptive step ignored SetResolution: got %v, want %v", got, 2*time.Millisecond)
+	}
+}
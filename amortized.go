@@ -0,0 +1,58 @@
+// amortized.go: Goroutine-free read path for ModeAmortized
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultAmortizedReads is the number of reads after which a ModeAmortized
+// cache refreshes its value even if it has not expired yet.
+const DefaultAmortizedReads = 64
+
+// ErrInvalidAmortizedReads is returned when WithAmortizedReads is given a non-positive count.
+var ErrInvalidAmortizedReads = errors.New("timecache: invalid amortized reads")
+
+// WithAmortizedReads sets the number of reads after which a ModeAmortized
+// cache refreshes its value regardless of its age. It has no effect in other
+// modes. The default is DefaultAmortizedReads.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(
+//		timecache.WithMode(timecache.ModeAmortized),
+//		timecache.WithAmortizedReads(1000),
+//	)
+func WithAmortizedReads(n int) Option {
+	return func(c *config) error {
+		if n < 1 {
+			return fmt.Errorf("%w: %d", ErrInvalidAmortizedReads, n)
+		}
+		c.amortizedReads = n
+		return nil
+	}
+}
+
+// readAmortized is the read path of ModeAmortized. It refreshes the cached
+// value inline on every amortizedReads-th read, or when the monotonic clock
+// shows that it is older than the resolution. The age check skips the wall
+// clock and is cheaper than time.Now().
+func (tc *TimeCache) readAmortized() {
+	n := atomic.AddUint64(&tc.readCount, 1)
+
+	// Load in the same order as boundedNano so a fresh age is never paired
+	// with a stale value.
+	mono := atomic.LoadInt64(&tc.cachedMonoNano)
+	nanos := atomic.LoadInt64(&tc.cachedTimeNano)
+	if n%tc.amortizedReads != 0 && int64(time.Since(tc.epoch))-mono < atomic.LoadInt64(&tc.resolution) {
+		return
+	}
+	tc.publish(nanos, time.Now())
+}
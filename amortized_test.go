@@ -0,0 +1,116 @@
+// amortized_test.go: Test suite for the goroutine-free amortized mode
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithAmortizedReadsValidation(t *testing.T) {
+	if _, err := NewWithOptions(WithAmortizedReads(0)); !errors.Is(err, ErrInvalidAmortizedReads) {
+		t.Errorf("WithAmortizedReads(0) error mismatch: got %v", err)
+	}
+}
+
+func TestModeAmortizedNoGoroutine(t *testing.T) {
+	before := runtime.NumGoroutine()
+	tc, err := NewWithOptions(WithMode(ModeAmortized))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+
+	if got := runtime.NumGoroutine(); got > before {
+		t.Errorf("ModeAmortized started goroutines: before=%d, after=%d", before, got)
+	}
+
+	// Lifecycle calls are harmless no-ops
+	tc.Start()
+	if got := runtime.NumGoroutine(); got > before {
+		t.Errorf("Start in ModeAmortized started goroutines: before=%d, after=%d", before, got)
+	}
+	select {
+	case <-tc.Done():
+	default:
+		t.Error("Done channel of ModeAmortized cache is not closed")
+	}
+	tc.Stop()
+	tc.Restart()
+}
+
+func TestModeAmortizedExpiry(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeAmortized),
+		WithResolution(time.Millisecond),
+		WithAmortizedReads(1<<30),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// Reads within the resolution return the cached value
+	first := tc.CachedTimeNano()
+	if second := tc.CachedTimeNano(); second != first {
+		t.Errorf("Unexpired value was refreshed: first=%d, second=%d", first, second)
+	}
+
+	// The first read after expiry refreshes inline
+	time.Sleep(3 * time.Millisecond)
+	before := time.Now().UnixNano()
+	if got := tc.CachedTimeNano(); got < before {
+		t.Errorf("Expired value was not refreshed: got %d, want >= %d", got, before)
+	}
+
+	// Every API honours the refresh, not just CachedTimeNano
+	time.Sleep(3 * time.Millisecond)
+	if age := time.Since(tc.CachedTime()); age > 2*time.Millisecond {
+		t.Errorf("CachedTime returned an expired value: age %v", age)
+	}
+	time.Sleep(3 * time.Millisecond)
+	if age := time.Since(tc.CachedMonotonic()); age > 2*time.Millisecond {
+		t.Errorf("CachedMonotonic returned an expired value: age %v", age)
+	}
+	if tc.CachedTimeString() == "" {
+		t.Error("CachedTimeString returned empty string")
+	}
+}
+
+func TestModeAmortizedEveryNReads(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeAmortized),
+		WithResolution(time.Hour),
+		WithAmortizedReads(4),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	stale := atomic.LoadInt64(&tc.cachedTimeNano)
+	time.Sleep(time.Millisecond)
+
+	// Three reads return the cached value, the fourth refreshes it
+	for i := 1; i < 4; i++ {
+		if got := tc.CachedTimeNano(); got != stale {
+			t.Errorf("Read %d refreshed the value: got %d, want %d", i, got, stale)
+		}
+	}
+	if got := tc.CachedTimeNano(); got == stale {
+		t.Error("Fourth read did not refresh the value")
+	}
+}
+
+func TestModeAmortizedParse(t *testing.T) {
+	mode, err := ParseMode("amortized")
+	if err != nil || mode != ModeAmortized {
+		t.Errorf("ParseMode(amortized) = %v, %v", mode, err)
+	}
+}
@@ -0,0 +1,102 @@
+// append.go: Zero-allocation append-style formatting
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import "time"
+
+// AppendCachedTime appends the cached time, formatted in UTC with layout, to dst
+// and returns the extended buffer.
+//
+// This is the zero-allocation path for textual output: when dst has enough
+// capacity no memory is allocated. time.RFC3339 and time.RFC3339Nano use a
+// dedicated fast path that avoids time.Format entirely; other layouts are
+// rendered with time.Time.AppendFormat.
+//
+// Example:
+//
+//	buf := pool.Get().([]byte)[:0]
+//	buf = tc.AppendCachedTime(buf, time.RFC3339Nano)
+//	buf = append(buf, " request served\n"...)
+func (tc *TimeCache) AppendCachedTime(dst []byte, layout string) []byte {
+	return appendTime(dst, tc.CachedTimeNano(), layout)
+}
+
+// AppendCachedTime appends the cached time from the default cache, formatted
+// in UTC with layout, to dst and returns the extended buffer.
+// See (*TimeCache).AppendCachedTime for details.
+//
+// Example:
+//
+//	buf = timecache.AppendCachedTime(buf[:0], time.RFC3339)
+func AppendCachedTime(dst []byte, layout string) []byte {
+	return loadDefault().AppendCachedTime(dst, layout)
+}
+
+// appendTime appends nanos formatted in UTC with layout to dst.
+func appendTime(dst []byte, nanos int64, layout string) []byte {
+	t := time.Unix(0, nanos).UTC()
+	switch layout {
+	case time.RFC3339:
+		return appendRFC3339(dst, t, false)
+	case time.RFC3339Nano:
+		return appendRFC3339(dst, t, true)
+	}
+	return t.AppendFormat(dst, layout)
+}
+
+// appendRFC3339 appends t, which must be in UTC, in RFC3339 form.
+// When nano is true, the fractional second is appended with trailing zeros
+// trimmed, matching time.RFC3339Nano.
+func appendRFC3339(dst []byte, t time.Time, nano bool) []byte {
+	year, month, day := t.Date()
+	if year < 0 || year > 9999 {
+		// Outside the four-digit range RFC3339 cannot represent; defer to time.Format.
+		if nano {
+			return t.AppendFormat(dst, time.RFC3339Nano)
+		}
+		return t.AppendFormat(dst, time.RFC3339)
+	}
+	hour, min, sec := t.Clock()
+
+	dst = appendInt(dst, year, 4)
+	dst = append(dst, '-')
+	dst = appendInt(dst, int(month), 2)
+	dst = append(dst, '-')
+	dst = appendInt(dst, day, 2)
+	dst = append(dst, 'T')
+	dst = appendInt(dst, hour, 2)
+	dst = append(dst, ':')
+	dst = appendInt(dst, min, 2)
+	dst = append(dst, ':')
+	dst = appendInt(dst, sec, 2)
+
+	if ns := t.Nanosecond(); nano && ns != 0 {
+		digits := 9
+		for ns%10 == 0 {
+			ns /= 10
+			digits--
+		}
+		dst = append(dst, '.')
+		dst = appendInt(dst, ns, digits)
+	}
+	return append(dst, 'Z')
+}
+
+// appendInt appends the non-negative v zero-padded to width digits.
+func appendInt(dst []byte, v, width int) []byte {
+	var buf [9]byte
+	i := len(buf)
+	for v >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + v%10)
+		v /= 10
+		width--
+	}
+	i--
+	buf[i] = byte('0' + v)
+	return append(dst, buf[i:]...)
+}
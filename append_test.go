@@ -0,0 +1,81 @@
+// append_test.go: Test suite for append-style formatting
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestAppendRFC3339MatchesFormat(t *testing.T) {
+	instants := []time.Time{
+		time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Date(1970, 1, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(2000, 2, 29, 12, 0, 0, 120000000, time.UTC),
+		time.Date(2262, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		instants = append(instants, time.Unix(0, rng.Int63()).UTC())
+	}
+
+	for _, instant := range instants {
+		for _, layout := range []string{time.RFC3339, time.RFC3339Nano} {
+			want := instant.Format(layout)
+			got := string(appendTime(nil, instant.UnixNano(), layout))
+			if got != want {
+				t.Fatalf("appendTime(%q) mismatch: got %s, want %s", layout, got, want)
+			}
+		}
+	}
+}
+
+func TestAppendCachedTime(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	prefix := []byte("ts=")
+	buf := tc.AppendCachedTime(prefix, time.RFC3339Nano)
+	if want := "ts=" + tc.CachedTimeString(); string(buf) != want {
+		t.Errorf("AppendCachedTime mismatch: got %s, want %s", buf, want)
+	}
+
+	const layout = "2006-01-02 15:04:05.000"
+	want := time.Unix(0, tc.CachedTimeNano()).UTC().Format(layout)
+	if got := string(tc.AppendCachedTime(nil, layout)); got != want {
+		t.Errorf("AppendCachedTime custom layout mismatch: got %s, want %s", got, want)
+	}
+
+	if _, err := time.Parse(time.RFC3339, string(AppendCachedTime(nil, time.RFC3339))); err != nil {
+		t.Errorf("Global AppendCachedTime produced invalid RFC3339: %v", err)
+	}
+}
+
+func TestAppendCachedTimeZeroAlloc(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	buf := make([]byte, 0, 64)
+	for _, layout := range []string{time.RFC3339, time.RFC3339Nano, time.Kitchen} {
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = tc.AppendCachedTime(buf[:0], layout)
+		})
+		if allocs != 0 {
+			t.Errorf("AppendCachedTime(%q) allocated %v times per call, want 0", layout, allocs)
+		}
+	}
+}
+
+func BenchmarkAppendCachedTimeRFC3339Nano(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendCachedTime(buf[:0], time.RFC3339Nano)
+	}
+}
@@ -0,0 +1,140 @@
+// clock.go: Clock abstraction and deterministic fake clock
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the read-only view of a time cache.
+// It is satisfied by *TimeCache and *FakeClock, which allows code built on
+// top of timecache to accept a Clock and be driven by a FakeClock in tests
+// instead of relying on real sleeps.
+//
+// Example:
+//
+//	type Session struct {
+//		clock    timecache.Clock
+//		deadline int64
+//	}
+//
+//	func (s *Session) Expired() bool {
+//		return s.clock.CachedTimeNano() > s.deadline
+//	}
+type Clock interface {
+	// CachedTimeNano returns the current time in nanoseconds since Unix epoch.
+	CachedTimeNano() int64
+
+	// CachedTime returns the current time as a time.Time value.
+	CachedTime() time.Time
+
+	// CachedTimeString returns the current time formatted as an RFC3339Nano string in UTC.
+	CachedTimeString() string
+}
+
+// Compile-time checks that both implementations satisfy Clock.
+var (
+	_ Clock = (*TimeCache)(nil)
+	_ Clock = (*FakeClock)(nil)
+)
+
+// FakeClock is a manually driven Clock intended for tests.
+//
+// A FakeClock created with NewFakeClock is frozen: it reports the same instant
+// until it is moved with Set or Advance. Calling Unfreeze makes it follow the
+// real clock from its current reading, and Freeze pins it again. All methods
+// are safe for concurrent use.
+type FakeClock struct {
+	mu sync.Mutex
+
+	// nanos is the frozen instant while frozen, or the offset from
+	// time.Now().UnixNano() while running.
+	nanos int64
+
+	// running reports whether the clock follows the real clock.
+	running bool
+}
+
+// NewFakeClock creates a frozen FakeClock reporting the given instant.
+//
+// Example:
+//
+//	clock := timecache.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	clock.Advance(30 * time.Second)
+//	fmt.Println(clock.CachedTimeString()) // 2025-01-01T00:00:30Z
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{nanos: t.UnixNano()}
+}
+
+// CachedTimeNano returns the fake time in nanoseconds since Unix epoch.
+func (fc *FakeClock) CachedTimeNano() int64 {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.nowLocked()
+}
+
+// CachedTime returns the fake time as a time.Time value.
+func (fc *FakeClock) CachedTime() time.Time {
+	return time.Unix(0, fc.CachedTimeNano())
+}
+
+// CachedTimeString returns the fake time formatted as an RFC3339Nano string in UTC,
+// matching the format of (*TimeCache).CachedTimeString.
+func (fc *FakeClock) CachedTimeString() string {
+	return time.Unix(0, fc.CachedTimeNano()).UTC().Format(time.RFC3339Nano)
+}
+
+// Set moves the clock to the given instant.
+// If the clock is running it keeps running from the new instant.
+func (fc *FakeClock) Set(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.running {
+		fc.nanos = t.UnixNano() - time.Now().UnixNano()
+		return
+	}
+	fc.nanos = t.UnixNano()
+}
+
+// Advance moves the clock forward by d. A negative d moves it backwards,
+// which is useful to simulate wall clock corrections.
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.nanos += int64(d)
+}
+
+// Freeze pins the clock at its current reading.
+// It is a no-op if the clock is already frozen.
+func (fc *FakeClock) Freeze() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.running {
+		fc.nanos = fc.nowLocked()
+		fc.running = false
+	}
+}
+
+// Unfreeze makes the clock follow the real clock from its current reading.
+// It is a no-op if the clock is already running.
+func (fc *FakeClock) Unfreeze() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if !fc.running {
+		fc.nanos -= time.Now().UnixNano()
+		fc.running = true
+	}
+}
+
+// nowLocked returns the current fake time. The caller must hold fc.mu.
+func (fc *FakeClock) nowLocked() int64 {
+	if fc.running {
+		return time.Now().UnixNano() + fc.nanos
+	}
+	return fc.nanos
+}
@@ -0,0 +1,97 @@
+// clock_test.go: Test suite for the Clock abstraction and fake clock
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClockFrozen(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	// A new fake clock must not move on its own
+	first := clock.CachedTimeNano()
+	time.Sleep(time.Millisecond)
+	second := clock.CachedTimeNano()
+
+	if first != start.UnixNano() || second != first {
+		t.Errorf("Frozen fake clock moved: start=%d, first=%d, second=%d",
+			start.UnixNano(), first, second)
+	}
+
+	if !clock.CachedTime().Equal(start) {
+		t.Errorf("CachedTime mismatch: got %v, want %v", clock.CachedTime(), start)
+	}
+
+	if got, want := clock.CachedTimeString(), "2025-01-01T12:00:00Z"; got != want {
+		t.Errorf("CachedTimeString mismatch: got %s, want %s", got, want)
+	}
+}
+
+func TestFakeClockSetAdvance(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	clock.Advance(90 * time.Second)
+	if want := start.Add(90 * time.Second); !clock.CachedTime().Equal(want) {
+		t.Errorf("Advance mismatch: got %v, want %v", clock.CachedTime(), want)
+	}
+
+	// Negative advances simulate backward wall clock steps
+	clock.Advance(-time.Minute)
+	if want := start.Add(30 * time.Second); !clock.CachedTime().Equal(want) {
+		t.Errorf("Negative Advance mismatch: got %v, want %v", clock.CachedTime(), want)
+	}
+
+	target := time.Date(2030, 6, 15, 8, 30, 0, 0, time.UTC)
+	clock.Set(target)
+	if !clock.CachedTime().Equal(target) {
+		t.Errorf("Set mismatch: got %v, want %v", clock.CachedTime(), target)
+	}
+}
+
+func TestFakeClockFreezeUnfreeze(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	// Running clock follows the real clock from its current reading
+	clock.Unfreeze()
+	time.Sleep(2 * time.Millisecond)
+	running := clock.CachedTimeNano()
+	if running-start.UnixNano() < int64(time.Millisecond) {
+		t.Errorf("Unfrozen fake clock did not progress: got %d ns", running-start.UnixNano())
+	}
+
+	// Freeze pins it again
+	clock.Freeze()
+	frozen := clock.CachedTimeNano()
+	time.Sleep(time.Millisecond)
+	if after := clock.CachedTimeNano(); after != frozen {
+		t.Errorf("Frozen fake clock moved: frozen=%d, after=%d", frozen, after)
+	}
+
+	// Set on a running clock keeps it running from the new instant
+	target := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock.Unfreeze()
+	clock.Set(target)
+	diff := clock.CachedTimeNano() - target.UnixNano()
+	if diff < 0 || diff > int64(time.Second) {
+		t.Errorf("Set on running clock mismatch: diff=%d ns", diff)
+	}
+}
+
+func TestTimeCacheImplementsClock(t *testing.T) {
+	tc := New()
+	defer tc.Stop()
+
+	var clock Clock = tc
+	if clock.CachedTimeNano() == 0 {
+		t.Error("TimeCache used as Clock returned zero timestamp")
+	}
+}
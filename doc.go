@@ -11,7 +11,7 @@
 //   - Configurable update resolution (precision vs CPU usage)
 //   - Thread-safe concurrent access from multiple goroutines
 //   - Multiple output formats: time.Time, nanoseconds, and formatted strings
-//   - Global default instance for convenience
+//   - Global default instance for convenience, started lazily on first use
 //
 // Performance Benefits:
 //   - CachedTime() is ~121x faster than time.Now()
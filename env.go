@@ -0,0 +1,129 @@
+// env.go: Environment-variable configuration of the default cache
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read when the default cache is created.
+const (
+	// EnvResolution sets the resolution of the default cache as a
+	// time.ParseDuration string, e.g. "1ms".
+	EnvResolution = "TIMECACHE_RESOLUTION"
+
+	// EnvDisable disables caching in the default cache when set to a true
+	// value accepted by strconv.ParseBool; reads then fall back to time.Now().
+	// It takes precedence over EnvMode.
+	EnvDisable = "TIMECACHE_DISABLE"
+
+	// EnvMode selects the Mode of the default cache by name, e.g. "ticker".
+	EnvMode = "TIMECACHE_MODE"
+)
+
+// envConfigErr records why the environment configuration of the default
+// cache was rejected, if it was. It is guarded by defaultMu.
+var envConfigErr error
+
+// OptionsFromEnv returns the Options described by the TIMECACHE_*
+// environment variables. Unset or empty variables contribute no option.
+//
+// Invalid values are reported as errors naming the offending variable rather
+// than causing a panic. The default cache is configured from these options
+// when it is created; applications can also apply them to their own caches.
+//
+// Example:
+//
+//	opts, err := timecache.OptionsFromEnv()
+//	if err != nil {
+//		log.Printf("ignoring timecache environment: %v", err)
+//	}
+//	tc, err := timecache.NewWithOptions(opts...)
+func OptionsFromEnv() ([]Option, error) {
+	var opts []Option
+
+	if v := os.Getenv(EnvResolution); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, envError(EnvResolution, v, err)
+		}
+		opt := WithResolution(d)
+		if err := opt(new(config)); err != nil {
+			return nil, envError(EnvResolution, v, err)
+		}
+		opts = append(opts, opt)
+	}
+
+	disabled := false
+	if v := os.Getenv(EnvDisable); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, envError(EnvDisable, v, err)
+		}
+		disabled = b
+	}
+
+	if v := os.Getenv(EnvMode); v != "" {
+		mode, err := ParseMode(v)
+		if err != nil {
+			return nil, envError(EnvMode, v, err)
+		}
+		if !disabled {
+			opts = append(opts, WithMode(mode))
+		}
+	}
+
+	if disabled {
+		opts = append(opts, WithMode(ModeDirect))
+	}
+	return opts, nil
+}
+
+// envError reports an invalid value of the environment variable name.
+// The cause names its origin, e.g. "timecache: invalid resolution" or
+// "time: invalid duration".
+func envError(name, value string, err error) error {
+	return fmt.Errorf("%s=%q: %w", name, value, err)
+}
+
+// newDefaultFromEnv creates the default cache from the environment.
+// Invalid settings are recorded in envConfigErr and replaced by the defaults;
+// valid ones clear the error left by an earlier creation.
+// The caller must hold defaultMu.
+func newDefaultFromEnv() *TimeCache {
+	var tc *TimeCache
+	opts, err := OptionsFromEnv()
+	if err == nil {
+		tc, err = NewWithOptions(opts...)
+	}
+	envConfigErr = err
+	if err != nil {
+		return New()
+	}
+	return tc
+}
+
+// DefaultConfigError returns the error that caused the TIMECACHE_*
+// environment configuration to be ignored when the default cache was
+// created, or nil if it was applied (or no variables were set).
+// The default cache is created by this call if it was not used yet.
+//
+// Example:
+//
+//	if err := timecache.DefaultConfigError(); err != nil {
+//		log.Printf("timecache: using defaults: %v", err)
+//	}
+func DefaultConfigError() error {
+	loadDefault()
+
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	return envConfigErr
+}
@@ -0,0 +1,135 @@
+// env_test.go: Test suite for environment-variable configuration
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv(EnvResolution, "2ms")
+	t.Setenv(EnvMode, "ticker")
+
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("OptionsFromEnv returned error: %v", err)
+	}
+	tc, err := NewWithOptions(opts...)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Resolution() != 2*time.Millisecond || tc.Mode() != ModeTicker {
+		t.Errorf("Env config not applied: resolution=%v, mode=%v", tc.Resolution(), tc.Mode())
+	}
+}
+
+func TestOptionsFromEnvUnset(t *testing.T) {
+	t.Setenv(EnvResolution, "")
+	t.Setenv(EnvDisable, "")
+	t.Setenv(EnvMode, "")
+
+	opts, err := OptionsFromEnv()
+	if err != nil || len(opts) != 0 {
+		t.Errorf("OptionsFromEnv with empty environment = %d options, %v", len(opts), err)
+	}
+}
+
+func TestOptionsFromEnvDisable(t *testing.T) {
+	// TIMECACHE_DISABLE takes precedence over TIMECACHE_MODE
+	t.Setenv(EnvDisable, "true")
+	t.Setenv(EnvMode, "ticker")
+
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("OptionsFromEnv returned error: %v", err)
+	}
+	tc, err := NewWithOptions(opts...)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Mode() != ModeDirect {
+		t.Errorf("TIMECACHE_DISABLE did not select direct mode: got %v", tc.Mode())
+	}
+}
+
+func TestOptionsFromEnvInvalid(t *testing.T) {
+	tests := []struct {
+		name, key, value string
+		want             error
+	}{
+		{"out of range resolution", EnvResolution, "0s", ErrInvalidResolution},
+		{"unknown mode", EnvMode, "bogus", ErrInvalidMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.key, tt.value)
+			if _, err := OptionsFromEnv(); !errors.Is(err, tt.want) {
+				t.Errorf("OptionsFromEnv error mismatch: got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// Messages name the variable once, followed by the cause
+	t.Setenv(EnvResolution, "0s")
+	if _, err := OptionsFromEnv(); err == nil || !strings.HasPrefix(err.Error(), `TIMECACHE_RESOLUTION="0s": timecache: invalid resolution`) {
+		t.Errorf("OptionsFromEnv error message mismatch: got %v", err)
+	}
+
+	// Unparsable values are reported too
+	t.Setenv(EnvResolution, "fast")
+	if _, err := OptionsFromEnv(); err == nil || !strings.HasPrefix(err.Error(), `TIMECACHE_RESOLUTION="fast": time: invalid duration`) {
+		t.Errorf("OptionsFromEnv error mismatch for an unparsable resolution: got %v", err)
+	}
+	t.Setenv(EnvResolution, "")
+	t.Setenv(EnvDisable, "maybe")
+	if _, err := OptionsFromEnv(); err == nil {
+		t.Error("OptionsFromEnv accepted an unparsable disable flag")
+	}
+}
+
+func TestNewDefaultFromEnvFallback(t *testing.T) {
+	defaultMu.Lock()
+	t.Setenv(EnvResolution, "-1ms")
+	tc := newDefaultFromEnv()
+	defaultMu.Unlock()
+	defer tc.Stop()
+
+	if tc.Resolution() != DefaultResolution {
+		t.Errorf("Invalid env did not fall back to defaults: got %v", tc.Resolution())
+	}
+	if !errors.Is(DefaultConfigError(), ErrInvalidResolution) {
+		t.Errorf("DefaultConfigError mismatch: got %v", DefaultConfigError())
+	}
+}
+
+func TestDefaultConfigErrorCleared(t *testing.T) {
+	original := SetDefault(nil)
+	defer SetDefault(original)
+
+	t.Setenv(EnvResolution, "-1ms")
+	if !errors.Is(DefaultConfigError(), ErrInvalidResolution) {
+		t.Fatalf("DefaultConfigError mismatch: got %v", DefaultConfigError())
+	}
+
+	// A later default created from a valid environment clears the error
+	t.Setenv(EnvResolution, "2ms")
+	SetDefault(nil)
+	if err := DefaultConfigError(); err != nil {
+		t.Errorf("DefaultConfigError not cleared after recreation: got %v", err)
+	}
+	if got := DefaultCache().Resolution(); got != 2*time.Millisecond {
+		t.Errorf("Recreated default resolution mismatch: got %v", got)
+	}
+}
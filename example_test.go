@@ -136,3 +136,19 @@ func ExampleDefaultCache() {
 	fmt.Printf("Time from default cache: %v\n", now)
 
 }
+
+func ExampleFakeClock() {
+	// Drive time manually in tests instead of sleeping
+	clock := timecache.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Code under test accepts the Clock interface
+	var c timecache.Clock = clock
+	fmt.Println(c.CachedTimeString())
+
+	clock.Advance(90 * time.Second)
+	fmt.Println(c.CachedTimeString())
+
+	// Output:
+	// 2025-01-01T00:00:00Z
+	// 2025-01-01T00:01:30Z
+}
@@ -0,0 +1,66 @@
+// format.go: Per-tick memoization of formatted time strings
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// formatted is an immutable rendering of a cached instant.
+// Instances are published atomically and never modified afterwards.
+type formatted struct {
+	// nanos is the cached instant this rendering belongs to, truncated to the
+	// granularity of the formatCache that produced it.
+	nanos int64
+
+	// s is the formatted representation of nanos.
+	s string
+
+	// b holds the same bytes as s for callers writing into byte buffers.
+	b []byte
+}
+
+// formatCache memoizes the rendering of a layout for the current cached instant.
+// The layout is formatted at most once per cache tick, or once per granularity
+// for layouts that cannot show finer detail; repeated reads in between return
+// the published rendering without allocating.
+type formatCache struct {
+	layout      string
+	loc         *time.Location
+	granularity int64
+	cur         atomic.Pointer[formatted]
+}
+
+// newFormatCache creates a formatCache rendering layout in loc once per tick.
+func newFormatCache(layout string, loc *time.Location) *formatCache {
+	return &formatCache{layout: layout, loc: loc, granularity: 1}
+}
+
+// newFormatCacheGranular creates a formatCache rendering layout in loc once per
+// granularity, for layouts whose output only changes at that interval
+// (e.g. once per second for layouts without fractional seconds).
+func newFormatCacheGranular(layout string, loc *time.Location, granularity time.Duration) *formatCache {
+	return &formatCache{layout: layout, loc: loc, granularity: int64(granularity)}
+}
+
+// get returns the rendering for nanos, formatting it only if the published
+// rendering belongs to a different instant. Concurrent readers racing on a
+// tick change may format the same instant more than once; the last one wins.
+func (fc *formatCache) get(nanos int64) *formatted {
+	nanos -= nanos % fc.granularity
+	if f := fc.cur.Load(); f != nil && f.nanos == nanos {
+		return f
+	}
+	// Clip the spare capacity so callers appending to a shared b reallocate
+	// instead of writing into the published array.
+	b := slices.Clip(time.Unix(0, nanos).In(fc.loc).AppendFormat(nil, fc.layout))
+	f := &formatted{nanos: nanos, s: string(b), b: b}
+	fc.cur.Store(f)
+	return f
+}
@@ -0,0 +1,53 @@
+// format_test.go: Test suite for per-tick formatted time memoization
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatCacheMemoization(t *testing.T) {
+	fc := newFormatCache(time.RFC3339Nano, time.UTC)
+	instant := time.Date(2025, 3, 4, 5, 6, 7, 890, time.UTC).UnixNano()
+
+	first := fc.get(instant)
+	if want := "2025-03-04T05:06:07.00000089Z"; first.s != want {
+		t.Errorf("Formatted string mismatch: got %s, want %s", first.s, want)
+	}
+
+	// Same instant must return the published rendering
+	if second := fc.get(instant); second != first {
+		t.Error("formatCache re-formatted an unchanged instant")
+	}
+
+	// A new instant must produce a new rendering
+	if third := fc.get(instant + int64(time.Second)); third == first || third.s == first.s {
+		t.Error("formatCache returned a stale rendering for a new instant")
+	}
+}
+
+func TestCachedTimeStringZeroAlloc(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	_ = tc.CachedTimeString() // warm up the memoized rendering
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = tc.CachedTimeString()
+	})
+	if allocs != 0 {
+		t.Errorf("CachedTimeString allocated %v times per call, want 0", allocs)
+	}
+}
+
+func BenchmarkCachedTimeString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = CachedTimeString()
+	}
+}
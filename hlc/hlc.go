@@ -0,0 +1,257 @@
+// hlc.go: Hybrid Logical Clock built on a time cache
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+// Package hlc implements Hybrid Logical Clocks on top of timecache.
+//
+// An HLC timestamp pairs a physical wall time with a logical counter. It stays
+// close to the physical clock while preserving causality across processes:
+// a timestamp generated after receiving a message always compares greater than
+// the timestamp carried by that message, even if the sender's clock is ahead.
+// The physical component is read from a timecache.Clock, so generating a
+// timestamp costs a cached read instead of a time.Now() call.
+//
+// Example:
+//
+//	tc := timecache.New()
+//	defer tc.Stop()
+//	clock := hlc.New(tc, 500*time.Millisecond)
+//
+//	msg.Timestamp = clock.Now() // on send
+//	if _, err := clock.Update(msg.Timestamp); err != nil { // on receive
+//		return err
+//	}
+package hlc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	timecache "github.com/agilira/go-timecache"
+)
+
+// EncodedLen is the length of the binary encoding of a Timestamp.
+const EncodedLen = 12
+
+var (
+	// ErrMaxDrift is returned by Update when a remote timestamp is further
+	// ahead of the local physical clock than the configured maximum drift.
+	ErrMaxDrift = errors.New("hlc: remote timestamp exceeds maximum drift")
+
+	// ErrInvalidTimestamp is returned when decoding or parsing a malformed Timestamp.
+	ErrInvalidTimestamp = errors.New("hlc: invalid timestamp")
+)
+
+// Timestamp is a hybrid logical timestamp. The zero value precedes every
+// timestamp issued by a Clock.
+type Timestamp struct {
+	// WallTime is the physical component in nanoseconds since Unix epoch.
+	WallTime int64
+
+	// Logical orders timestamps sharing the same WallTime.
+	Logical uint32
+}
+
+// Compare returns -1 if t precedes u, +1 if t follows u and 0 if they are equal.
+//
+// Example:
+//
+//	if a.Compare(b) < 0 {
+//		// a happened before b
+//	}
+func (t Timestamp) Compare(u Timestamp) int {
+	switch {
+	case t.WallTime < u.WallTime:
+		return -1
+	case t.WallTime > u.WallTime:
+		return 1
+	case t.Logical < u.Logical:
+		return -1
+	case t.Logical > u.Logical:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether t is the zero Timestamp.
+func (t Timestamp) IsZero() bool {
+	return t == Timestamp{}
+}
+
+// Time returns the physical component of t as a time.Time.
+func (t Timestamp) Time() time.Time {
+	return time.Unix(0, t.WallTime)
+}
+
+// String returns t as "<wall>,<logical>", e.g. "1735689600000000000,3".
+// Parse converts it back.
+func (t Timestamp) String() string {
+	return strconv.FormatInt(t.WallTime, 10) + "," + strconv.FormatUint(uint64(t.Logical), 10)
+}
+
+// Parse parses a Timestamp formatted by Timestamp.String.
+//
+// Example:
+//
+//	ts, err := hlc.Parse("1735689600000000000,3")
+func Parse(s string) (Timestamp, error) {
+	wall, logical, ok := strings.Cut(s, ",")
+	if !ok {
+		return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+	}
+	w, err := strconv.ParseInt(wall, 10, 64)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+	}
+	l, err := strconv.ParseUint(logical, 10, 32)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+	}
+	return Timestamp{WallTime: w, Logical: uint32(l)}, nil
+}
+
+// AppendBinary appends the EncodedLen-byte big-endian encoding of t to b.
+// Encodings of timestamps with non-negative wall times sort bytewise in
+// the same order as Compare.
+func (t Timestamp) AppendBinary(b []byte) ([]byte, error) {
+	b = binary.BigEndian.AppendUint64(b, uint64(t.WallTime))
+	return binary.BigEndian.AppendUint32(b, t.Logical), nil
+}
+
+// MarshalBinary returns the EncodedLen-byte big-endian encoding of t.
+func (t Timestamp) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(make([]byte, 0, EncodedLen))
+}
+
+// UnmarshalBinary decodes a Timestamp encoded by MarshalBinary.
+func (t *Timestamp) UnmarshalBinary(data []byte) error {
+	if len(data) != EncodedLen {
+		return fmt.Errorf("%w: %d bytes, want %d", ErrInvalidTimestamp, len(data), EncodedLen)
+	}
+	t.WallTime = int64(binary.BigEndian.Uint64(data))
+	t.Logical = binary.BigEndian.Uint32(data[8:])
+	return nil
+}
+
+// MarshalText returns the String form of t.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes a Timestamp in the String form.
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	ts, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*t = ts
+	return nil
+}
+
+// Clock issues hybrid logical timestamps whose physical component is read
+// from a timecache.Clock. It is safe for concurrent use.
+type Clock struct {
+	physical timecache.Clock
+	maxDrift time.Duration
+
+	// mu guards last.
+	mu sync.Mutex
+
+	// last is the greatest timestamp issued or observed so far.
+	last Timestamp
+}
+
+// New creates a Clock reading its physical component from physical, usually
+// a *timecache.TimeCache or, in tests, a *timecache.FakeClock.
+//
+// maxDrift bounds how far ahead of the local physical clock a remote
+// timestamp passed to Update may be; zero disables the check. New panics if
+// physical is nil or maxDrift is negative.
+//
+// Example:
+//
+//	clock := hlc.New(tc, 500*time.Millisecond)
+func New(physical timecache.Clock, maxDrift time.Duration) *Clock {
+	if physical == nil {
+		panic("hlc: nil physical clock")
+	}
+	if maxDrift < 0 {
+		panic("hlc: negative maximum drift")
+	}
+	return &Clock{physical: physical, maxDrift: maxDrift}
+}
+
+// Now returns a timestamp for a local or send event. It is strictly greater
+// than every timestamp previously returned by Now or Update on this Clock.
+//
+// Example:
+//
+//	msg.Timestamp = clock.Now()
+func (c *Clock) Now() Timestamp {
+	pt := c.physical.CachedTimeNano()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if pt > c.last.WallTime {
+		c.last = Timestamp{WallTime: pt}
+	} else {
+		c.last = successor(c.last.WallTime, c.last.Logical)
+	}
+	return c.last
+}
+
+// Update merges a timestamp received from a remote process and returns a
+// timestamp for the receive event, strictly greater than both remote and
+// every timestamp previously issued by this Clock.
+//
+// If remote is more than the maximum drift ahead of the local physical clock,
+// Update returns an error wrapping ErrMaxDrift and leaves the Clock unchanged,
+// so a single peer with a runaway clock cannot drag it into the future.
+//
+// Example:
+//
+//	ts, err := clock.Update(msg.Timestamp)
+//	if errors.Is(err, hlc.ErrMaxDrift) {
+//		return fmt.Errorf("rejecting message from %s: %w", peer, err)
+//	}
+func (c *Clock) Update(remote Timestamp) (Timestamp, error) {
+	pt := c.physical.CachedTimeNano()
+	if c.maxDrift > 0 && remote.WallTime-pt > int64(c.maxDrift) {
+		return Timestamp{}, fmt.Errorf("%w: remote is %v ahead of local physical time (max %v)",
+			ErrMaxDrift, time.Duration(remote.WallTime-pt), c.maxDrift)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wall := max(pt, c.last.WallTime, remote.WallTime)
+	switch {
+	case wall == c.last.WallTime && wall == remote.WallTime:
+		c.last = successor(wall, max(c.last.Logical, remote.Logical))
+	case wall == c.last.WallTime:
+		c.last = successor(wall, c.last.Logical)
+	case wall == remote.WallTime:
+		c.last = successor(wall, remote.Logical)
+	default:
+		c.last = Timestamp{WallTime: wall}
+	}
+	return c.last, nil
+}
+
+// successor returns the smallest timestamp after (wall, logical), carrying
+// into the wall time if the logical counter is exhausted.
+func successor(wall int64, logical uint32) Timestamp {
+	if logical == math.MaxUint32 {
+		return Timestamp{WallTime: wall + 1}
+	}
+	return Timestamp{WallTime: wall, Logical: logical + 1}
+}
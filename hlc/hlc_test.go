@@ -0,0 +1,210 @@
+// hlc_test.go: Test suite for the Hybrid Logical Clock
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package hlc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	timecache "github.com/agilira/go-timecache"
+)
+
+var start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNow(t *testing.T) {
+	physical := timecache.NewFakeClock(start)
+	clock := New(physical, 0)
+
+	// A frozen physical clock advances the logical counter
+	first := clock.Now()
+	second := clock.Now()
+	if first != (Timestamp{WallTime: start.UnixNano()}) {
+		t.Errorf("First timestamp mismatch: got %v", first)
+	}
+	if second != (Timestamp{WallTime: start.UnixNano(), Logical: 1}) {
+		t.Errorf("Second timestamp mismatch: got %v", second)
+	}
+
+	// A moving physical clock resets it
+	physical.Advance(time.Millisecond)
+	if got, want := clock.Now(), (Timestamp{WallTime: start.Add(time.Millisecond).UnixNano()}); got != want {
+		t.Errorf("Timestamp after advance: got %v, want %v", got, want)
+	}
+
+	// A backward physical step never makes timestamps go backwards
+	physical.Advance(-time.Second)
+	if got := clock.Now(); got.Compare(second) <= 0 || got.WallTime != start.Add(time.Millisecond).UnixNano() {
+		t.Errorf("Timestamp after backward step: got %v", got)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	physical := timecache.NewFakeClock(start)
+	clock := New(physical, time.Second)
+	local := clock.Now()
+
+	tests := []struct {
+		name   string
+		remote Timestamp
+		want   Timestamp
+	}{
+		{"remote behind", Timestamp{WallTime: local.WallTime - 10, Logical: 7}, Timestamp{WallTime: local.WallTime, Logical: 1}},
+		{"same wall time", Timestamp{WallTime: local.WallTime, Logical: 5}, Timestamp{WallTime: local.WallTime, Logical: 6}},
+		{"remote ahead", Timestamp{WallTime: local.WallTime + 100, Logical: 2}, Timestamp{WallTime: local.WallTime + 100, Logical: 3}},
+	}
+	for _, tt := range tests {
+		got, err := clock.Update(tt.remote)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Update(%v) = %v, %v; want %v", tt.name, tt.remote, got, err, tt.want)
+		}
+	}
+
+	// The physical clock overtaking both resets the logical counter
+	physical.Advance(time.Millisecond)
+	got, err := clock.Update(Timestamp{WallTime: local.WallTime})
+	if want := (Timestamp{WallTime: start.Add(time.Millisecond).UnixNano()}); err != nil || got != want {
+		t.Errorf("Update with newer physical time = %v, %v; want %v", got, err, want)
+	}
+}
+
+func TestUpdateMaxDrift(t *testing.T) {
+	physical := timecache.NewFakeClock(start)
+	clock := New(physical, time.Second)
+	before := clock.Now()
+
+	remote := Timestamp{WallTime: start.Add(2 * time.Second).UnixNano()}
+	if _, err := clock.Update(remote); !errors.Is(err, ErrMaxDrift) {
+		t.Errorf("Update beyond max drift error mismatch: got %v", err)
+	}
+
+	// A rejected timestamp leaves the clock unchanged
+	if got := clock.Now(); got != (Timestamp{WallTime: before.WallTime, Logical: 1}) {
+		t.Errorf("Rejected update changed the clock: got %v", got)
+	}
+
+	// Without a drift bound any remote time is accepted
+	unbounded := New(physical, 0)
+	if got, err := unbounded.Update(remote); err != nil || got.WallTime != remote.WallTime {
+		t.Errorf("Unbounded Update = %v, %v", got, err)
+	}
+}
+
+func TestLogicalOverflow(t *testing.T) {
+	if got := successor(10, math.MaxUint32); got != (Timestamp{WallTime: 11}) {
+		t.Errorf("successor carry mismatch: got %v", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := Timestamp{WallTime: 1, Logical: 5}
+	b := Timestamp{WallTime: 2}
+	c := Timestamp{WallTime: 2, Logical: 1}
+
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || b.Compare(c) != -1 || c.Compare(c) != 0 {
+		t.Error("Compare ordering mismatch")
+	}
+	if !(Timestamp{}).IsZero() || a.IsZero() {
+		t.Error("IsZero mismatch")
+	}
+	if !b.Time().Equal(time.Unix(0, 2)) {
+		t.Errorf("Time mismatch: got %v", b.Time())
+	}
+}
+
+func TestBinaryEncoding(t *testing.T) {
+	ts := Timestamp{WallTime: start.UnixNano(), Logical: 42}
+	data, err := ts.MarshalBinary()
+	if err != nil || len(data) != EncodedLen {
+		t.Fatalf("MarshalBinary = %x, %v", data, err)
+	}
+
+	var decoded Timestamp
+	if err := decoded.UnmarshalBinary(data); err != nil || decoded != ts {
+		t.Errorf("UnmarshalBinary = %v, %v; want %v", decoded, err, ts)
+	}
+	if err := decoded.UnmarshalBinary(data[:5]); !errors.Is(err, ErrInvalidTimestamp) {
+		t.Errorf("UnmarshalBinary short input error mismatch: got %v", err)
+	}
+
+	// Encodings sort like the timestamps
+	next, _ := Timestamp{WallTime: ts.WallTime, Logical: 43}.MarshalBinary()
+	later, _ := Timestamp{WallTime: ts.WallTime + 1}.MarshalBinary()
+	if bytes.Compare(data, next) >= 0 || bytes.Compare(next, later) >= 0 {
+		t.Error("Binary encodings do not sort like timestamps")
+	}
+}
+
+func TestStringEncoding(t *testing.T) {
+	ts := Timestamp{WallTime: 1735689600000000000, Logical: 3}
+	if got, want := ts.String(), "1735689600000000000,3"; got != want {
+		t.Errorf("String mismatch: got %s, want %s", got, want)
+	}
+	if got, err := Parse(ts.String()); err != nil || got != ts {
+		t.Errorf("Parse(String()) = %v, %v; want %v", got, err, ts)
+	}
+
+	for _, s := range []string{"", "123", "abc,1", "1,abc", "1,4294967296", "1,-1"} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidTimestamp) {
+			t.Errorf("Parse(%q) error mismatch: got %v", s, err)
+		}
+	}
+
+	// Text marshaling makes timestamps usable in JSON
+	data, err := json.Marshal(map[string]Timestamp{"ts": ts})
+	if err != nil || string(data) != `{"ts":"1735689600000000000,3"}` {
+		t.Fatalf("json.Marshal = %s, %v", data, err)
+	}
+	var decoded map[string]Timestamp
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded["ts"] != ts {
+		t.Errorf("json.Unmarshal = %v, %v", decoded, err)
+	}
+}
+
+func TestConcurrentNow(t *testing.T) {
+	tc := timecache.NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+	clock := New(tc, 0)
+
+	const goroutines, perGoroutine = 8, 2000
+	results := make(chan []Timestamp, goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			stamps := make([]Timestamp, perGoroutine)
+			for j := range stamps {
+				stamps[j] = clock.Now()
+			}
+			results <- stamps
+		}()
+	}
+
+	seen := make(map[Timestamp]bool, goroutines*perGoroutine)
+	for i := 0; i < goroutines; i++ {
+		stamps := <-results
+		for j, ts := range stamps {
+			if j > 0 && ts.Compare(stamps[j-1]) <= 0 {
+				t.Fatalf("Timestamps not increasing within a goroutine: %v after %v", ts, stamps[j-1])
+			}
+			if seen[ts] {
+				t.Fatalf("Duplicate timestamp %v", ts)
+			}
+			seen[ts] = true
+		}
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("New with nil physical clock did not panic")
+		}
+	}()
+	New(nil, 0)
+}
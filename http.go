@@ -0,0 +1,48 @@
+// http.go: Cached HTTP Date header support
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+// HTTPDateLayout is the layout of the HTTP Date header (RFC 1123 IMF-fixdate),
+// e.g. "Mon, 02 Jan 2006 15:04:05 GMT". It equals http.TimeFormat and is
+// defined here so that importing timecache does not link net/http.
+const HTTPDateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// HTTPDate returns the cached time formatted for the HTTP Date header
+// (RFC 1123 IMF-fixdate, HTTPDateLayout), e.g. "Mon, 02 Jan 2006 15:04:05 GMT".
+//
+// The string is rendered at most once per second and shared by all callers,
+// so repeated reads are zero-allocation. The httpdate subpackage provides
+// middleware setting the header from a cache.
+//
+// Example:
+//
+//	w.Header().Set("Date", tc.HTTPDate())
+func (tc *TimeCache) HTTPDate() string {
+	return tc.httpDate.get(tc.CachedTimeNano()).s
+}
+
+// HTTPDateBytes returns the same value as HTTPDate as a byte slice, for servers
+// writing headers into raw buffers. The slice is shared with other callers and
+// must not be modified.
+//
+// Example:
+//
+//	buf = append(buf, "Date: "...)
+//	buf = append(buf, tc.HTTPDateBytes()...)
+func (tc *TimeCache) HTTPDateBytes() []byte {
+	return tc.httpDate.get(tc.CachedTimeNano()).b
+}
+
+// HTTPDate returns the cached time from the default cache formatted for the
+// HTTP Date header. See (*TimeCache).HTTPDate for details.
+//
+// Example:
+//
+//	w.Header().Set("Date", timecache.HTTPDate())
+func HTTPDate() string {
+	return loadDefault().HTTPDate()
+}
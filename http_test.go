@@ -0,0 +1,71 @@
+// http_test.go: Test suite for cached HTTP Date header support
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHTTPDate(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	want := time.Unix(0, tc.CachedTimeNano()).UTC().Format(HTTPDateLayout)
+	if got := tc.HTTPDate(); got != want {
+		t.Errorf("HTTPDate mismatch: got %s, want %s", got, want)
+	}
+	if got := string(tc.HTTPDateBytes()); got != want {
+		t.Errorf("HTTPDateBytes mismatch: got %s, want %s", got, want)
+	}
+
+	if _, err := time.Parse(HTTPDateLayout, HTTPDate()); err != nil {
+		t.Errorf("Global HTTPDate is not a valid HTTP date: %v", err)
+	}
+}
+
+func TestHTTPDateBytesAppend(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	want := tc.HTTPDate()
+
+	// Concurrent handlers terminating the header must not share storage
+	lines := make(chan []byte, 8)
+	for i := 0; i < cap(lines); i++ {
+		go func() { lines <- append(tc.HTTPDateBytes(), '\r', '\n') }()
+	}
+	for i := 0; i < cap(lines); i++ {
+		if got := string(<-lines); got != want+"\r\n" {
+			t.Errorf("Appended header line mismatch: got %q, want %q", got, want+"\r\n")
+		}
+	}
+
+	x := append(tc.HTTPDateBytes(), 'A')
+	y := append(tc.HTTPDateBytes(), 'B')
+	if string(x) != want+"A" || string(y) != want+"B" {
+		t.Errorf("Appended results share storage: x=%q, y=%q", x, y)
+	}
+}
+
+func TestHTTPDateGranularity(t *testing.T) {
+	fc := newFormatCacheGranular(HTTPDateLayout, time.UTC, time.Second)
+	second := time.Date(2025, 1, 1, 0, 0, 10, 0, time.UTC).UnixNano()
+
+	// Instants within the same second share one rendering
+	first := fc.get(second + int64(100*time.Millisecond))
+	if again := fc.get(second + int64(900*time.Millisecond)); again != first {
+		t.Error("HTTP date re-rendered within the same second")
+	}
+	if want := "Wed, 01 Jan 2025 00:00:10 GMT"; first.s != want {
+		t.Errorf("HTTP date mismatch: got %s, want %s", first.s, want)
+	}
+
+	if next := fc.get(second + int64(time.Second)); next == first {
+		t.Error("HTTP date not refreshed on the next second")
+	}
+}
@@ -0,0 +1,57 @@
+// httpdate.go: HTTP Date header middleware backed by a time cache
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+// Package httpdate provides net/http middleware that sets the Date response
+// header from a timecache.TimeCache.
+//
+// net/http only formats its own Date header when the handler did not set one,
+// so the middleware replaces a time.Now() and a Format call per response with
+// a cached read. It lives in its own package so that importing timecache does
+// not link net/http.
+//
+// Example:
+//
+//	http.ListenAndServe(":8080", httpdate.Middleware(mux))
+package httpdate
+
+import (
+	"net/http"
+
+	timecache "github.com/agilira/go-timecache"
+)
+
+// Middleware returns middleware that sets the Date response header from the
+// default cache before calling next. The default cache is resolved on every
+// request, so the middleware follows timecache.SetDefault and
+// timecache.ConfigureDefault.
+//
+// Example:
+//
+//	http.ListenAndServe(":8080", httpdate.Middleware(mux))
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header()["Date"] = []string{timecache.HTTPDate()}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MiddlewareFor returns middleware that sets the Date response header from tc
+// before calling next. It panics if tc is nil.
+//
+// Example:
+//
+//	tc := timecache.New()
+//	defer tc.Stop()
+//	http.ListenAndServe(":8080", httpdate.MiddlewareFor(tc, mux))
+func MiddlewareFor(tc *timecache.TimeCache, next http.Handler) http.Handler {
+	if tc == nil {
+		panic("httpdate: MiddlewareFor called with nil cache")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header()["Date"] = []string{tc.HTTPDate()}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,58 @@
+// httpdate_test.go: Test suite for the HTTP Date header middleware
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package httpdate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	timecache "github.com/agilira/go-timecache"
+)
+
+func TestHTTPDateLayout(t *testing.T) {
+	if timecache.HTTPDateLayout != http.TimeFormat {
+		t.Errorf("HTTPDateLayout mismatch: got %q, want %q", timecache.HTTPDateLayout, http.TimeFormat)
+	}
+}
+
+func TestMiddlewareFor(t *testing.T) {
+	tc := timecache.NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	handler := MiddlewareFor(tc, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if got := rec.Header().Get("Date"); got != tc.HTTPDate() {
+		t.Errorf("Date header mismatch: got %q, want %q", got, tc.HTTPDate())
+	}
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Wrapped handler not called: status %d", rec.Code)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Middleware(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if _, err := http.ParseTime(rec.Header().Get("Date")); err != nil {
+		t.Errorf("Middleware set invalid Date header: %v", err)
+	}
+}
+
+func TestMiddlewareForNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MiddlewareFor(nil) did not panic")
+		}
+	}()
+	MiddlewareFor(nil, http.NotFoundHandler())
+}
@@ -0,0 +1,121 @@
+// idle.go: Idle-aware updater for ModeIdle
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultIdleTicks is the number of consecutive ticks without reads after
+// which a ModeIdle cache pauses its updater (about one second at
+// DefaultResolution).
+const DefaultIdleTicks = 2000
+
+// ErrInvalidIdleTicks is returned when WithIdleTicks is given a non-positive count.
+var ErrInvalidIdleTicks = errors.New("timecache: invalid idle ticks")
+
+// WithIdleTicks sets the number of consecutive ticks without reads after which
+// a ModeIdle cache pauses its updater. It has no effect in other modes.
+// The default is DefaultIdleTicks.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(
+//		timecache.WithMode(timecache.ModeIdle),
+//		timecache.WithIdleTicks(100),
+//	)
+func WithIdleTicks(n int) Option {
+	return func(c *config) error {
+		if n < 1 {
+			return fmt.Errorf("%w: %d", ErrInvalidIdleTicks, n)
+		}
+		c.idleTicks = n
+		return nil
+	}
+}
+
+// readIdle is the read path of ModeIdle. It records the read and, if the
+// updater is paused, refreshes the value inline and wakes the updater.
+func (tc *TimeCache) readIdle() {
+	tc.markRead()
+	if atomic.LoadUint32(&tc.idle) == 0 {
+		return
+	}
+
+	// Every reader that observes the pause refreshes inline, so no reader
+	// gets a value older than its own read; only one of them wakes the updater.
+	// Loading before reading the clock lets a preempted refresher lose to a
+	// newer update instead of publishing an older time.
+	tc.publish(atomic.LoadInt64(&tc.cachedTimeNano), time.Now())
+	if atomic.CompareAndSwapUint32(&tc.idle, 1, 0) {
+		select {
+		case tc.wakeCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// markRead records that the cache was read since the last tick.
+// The flag is only written when clear, so concurrent readers do not keep
+// invalidating each other's cache line.
+func (tc *TimeCache) markRead() {
+	if atomic.LoadUint32(&tc.reads) == 0 {
+		atomic.StoreUint32(&tc.reads, 1)
+	}
+}
+
+// idleTick accounts for one tick of the updater and reports whether it has
+// seen idleTicks consecutive ticks without reads.
+func (tc *TimeCache) idleTick(quiet *int) bool {
+	if atomic.SwapUint32(&tc.reads, 0) == 1 {
+		*quiet = 0
+		return false
+	}
+	*quiet++
+	if *quiet < tc.idleTicks {
+		return false
+	}
+	*quiet = 0
+	return true
+}
+
+// pause stops the ticker until a reader wakes the updater. It reports false
+// if the updater must exit instead because it was stopped or its context is done.
+func (tc *TimeCache) pause(ticker *time.Ticker, stop chan struct{}) bool {
+	ticker.Stop()
+	tc.stats.pause()
+	atomic.StoreUint32(&tc.idle, 1)
+
+	select {
+	case <-tc.wakeCh:
+		ticker.Reset(tc.Resolution())
+		tc.stats.resume(time.Now())
+		return true
+	case <-stop:
+	case <-tc.ctx.Done():
+		tc.contextDone(stop)
+	}
+
+	// A stopped cache returns its last value instead of refreshing inline.
+	atomic.StoreUint32(&tc.idle, 0)
+	return false
+}
+
+// Idle reports whether the updater of a ModeIdle cache is currently paused.
+// It is always false in other modes.
+//
+// Example:
+//
+//	if tc.Idle() {
+//		log.Println("timecache updater paused")
+//	}
+func (tc *TimeCache) Idle() bool {
+	return atomic.LoadUint32(&tc.idle) == 1
+}
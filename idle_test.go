@@ -0,0 +1,148 @@
+// idle_test.go: Test suite for the idle-aware updater
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitIdle polls until tc reports a paused updater or the timeout expires.
+func waitIdle(tc *TimeCache, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if tc.Idle() {
+			return true
+		}
+		time.Sleep(100 * time.Microsecond)
+	}
+	return false
+}
+
+func TestWithIdleTicksValidation(t *testing.T) {
+	if _, err := NewWithOptions(WithIdleTicks(0)); !errors.Is(err, ErrInvalidIdleTicks) {
+		t.Errorf("WithIdleTicks(0) error mismatch: got %v", err)
+	}
+}
+
+func TestModeIdlePausesAndWakes(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeIdle),
+		WithResolution(100*time.Microsecond),
+		WithIdleTicks(5),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if !waitIdle(tc, time.Second) {
+		t.Fatal("Updater did not pause without reads")
+	}
+
+	// While paused the published value must not move
+	paused := atomic.LoadInt64(&tc.cachedTimeNano)
+	time.Sleep(2 * time.Millisecond)
+	if after := atomic.LoadInt64(&tc.cachedTimeNano); after != paused {
+		t.Fatalf("Paused updater kept ticking: paused=%d, after=%d", paused, after)
+	}
+
+	// The first read refreshes inline and wakes the updater
+	before := time.Now().UnixNano()
+	read := tc.CachedTimeNano()
+	if read < before {
+		t.Errorf("Read after pause returned a stale value: read=%d, before=%d", read, before)
+	}
+	if tc.Idle() {
+		t.Error("Updater still idle after a read")
+	}
+
+	// Keep reading so the updater stays awake, and check it progresses
+	deadline := time.Now().Add(5 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		_ = tc.CachedTimeNano()
+		time.Sleep(50 * time.Microsecond)
+	}
+	if progressed := atomic.LoadInt64(&tc.cachedTimeNano); progressed <= read {
+		t.Errorf("Updater did not resume after wake: read=%d, progressed=%d", read, progressed)
+	}
+}
+
+func TestModeIdleStopWhilePaused(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithMode(ModeIdle),
+		WithResolution(100*time.Microsecond),
+		WithIdleTicks(1),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+
+	if !waitIdle(tc, time.Second) {
+		t.Fatal("Updater did not pause without reads")
+	}
+	tc.Stop()
+
+	// A stopped cache returns its last value instead of refreshing inline
+	stopped := tc.CachedTimeNano()
+	time.Sleep(time.Millisecond)
+	if after := tc.CachedTimeNano(); after != stopped {
+		t.Errorf("Stopped idle cache kept refreshing: stopped=%d, after=%d", stopped, after)
+	}
+}
+
+func TestModeTickerNeverIdle(t *testing.T) {
+	tc := NewWithResolution(100 * time.Microsecond)
+	defer tc.Stop()
+
+	time.Sleep(5 * time.Millisecond)
+	if tc.Idle() {
+		t.Error("ModeTicker cache reported an idle updater")
+	}
+}
+
+func TestReadIdleNeverRegresses(t *testing.T) {
+	tc, err := NewWithOptions(WithMode(ModeIdle), WithResolution(time.Hour))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	tc.Stop()
+
+	// Concurrent inline refreshers racing on a pause must only move time forward
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		go func() {
+			for {
+				select {
+				case <-stop:
+					done <- struct{}{}
+					return
+				default:
+				}
+				atomic.StoreUint32(&tc.idle, 1)
+				tc.readIdle()
+			}
+		}()
+	}
+
+	prev := atomic.LoadInt64(&tc.cachedTimeNano)
+	for deadline := time.Now().Add(50 * time.Millisecond); time.Now().Before(deadline); {
+		cur := atomic.LoadInt64(&tc.cachedTimeNano)
+		if cur < prev {
+			t.Errorf("Published time went backwards by %v", time.Duration(prev-cur))
+			break
+		}
+		prev = cur
+	}
+	close(stop)
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+}
@@ -0,0 +1,99 @@
+// interval.go: Age and error bounds of the cached time
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Age returns the time elapsed since the cached value was last updated,
+// measured on the monotonic clock. It is normally below the resolution and
+// grows when the updater is stopped, paused or starved. In ModeDirect it is
+// always zero. Age does not count as a read.
+//
+// Example:
+//
+//	if tc.Age() > 10*time.Millisecond {
+//		log.Println("timecache updater is lagging")
+//	}
+func (tc *TimeCache) Age() time.Duration {
+	if tc.mode == ModeDirect {
+		return 0
+	}
+	return tc.age(atomic.LoadInt64(&tc.cachedMonoNano))
+}
+
+// CachedInterval returns bounds on the current time derived from the cached
+// value: the true time is no earlier than earliest and no later than latest.
+// earliest is the cached time and latest adds the larger of the resolution
+// and the age of the value, so the interval stays valid when the updater
+// lags behind. In ModeDirect both bounds are the current time.
+//
+// Ordering decisions can be made conservatively with the interval instead
+// of trusting a point value: an event is known to be in the past only if it
+// precedes earliest, and in the future only if it follows latest. The bounds
+// assume the wall clock is not stepped in the meantime.
+//
+// Example:
+//
+//	earliest, latest := tc.CachedInterval()
+//	switch {
+//	case latest.Before(lease.ExpiresAt):
+//		// lease certainly still valid
+//	case earliest.After(lease.ExpiresAt):
+//		// lease certainly expired
+//	default:
+//		// too close to call; fall back to time.Now() or wait
+//	}
+func (tc *TimeCache) CachedInterval() (earliest, latest time.Time) {
+	if tc.mode == ModeDirect {
+		now := time.Unix(0, tc.CachedTimeNano())
+		return now, now
+	}
+	if !tc.plain {
+		// Apply mode side effects and the staleness bound, which may refresh the value.
+		tc.readNano(tc.maxStaleness, tc.maxStaleness > 0)
+	}
+
+	// Loading the monotonic time first never pairs a value with a smaller age
+	// than its own, so the interval errs on the wide side.
+	width := tc.age(atomic.LoadInt64(&tc.cachedMonoNano))
+	earliest = time.Unix(0, atomic.LoadInt64(&tc.cachedTimeNano))
+	if resolution := tc.Resolution(); width < resolution {
+		width = resolution
+	}
+	return earliest, earliest.Add(width)
+}
+
+// age returns the time elapsed since the monotonic offset mono, never negative.
+func (tc *TimeCache) age(mono int64) time.Duration {
+	if age := time.Since(tc.epoch) - time.Duration(mono); age > 0 {
+		return age
+	}
+	return 0
+}
+
+// Age returns the time elapsed since the default cache was last updated.
+// See (*TimeCache).Age for details.
+//
+// Example:
+//
+//	lag := timecache.Age()
+func Age() time.Duration {
+	return loadDefault().Age()
+}
+
+// CachedInterval returns bounds on the current time from the default cache.
+// See (*TimeCache).CachedInterval for details.
+//
+// Example:
+//
+//	earliest, latest := timecache.CachedInterval()
+func CachedInterval() (earliest, latest time.Time) {
+	return loadDefault().CachedInterval()
+}
@@ -0,0 +1,78 @@
+// interval_test.go: Test suite for the age and error bound API
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	if age := tc.Age(); age < 0 || age > 50*time.Millisecond {
+		t.Errorf("Age of a running cache out of range: %v", age)
+	}
+
+	// A stopped cache ages with the monotonic clock
+	tc.Stop()
+	time.Sleep(5 * time.Millisecond)
+	if age := tc.Age(); age < 5*time.Millisecond {
+		t.Errorf("Age of a stopped cache too small: %v", age)
+	}
+
+	direct, err := NewWithOptions(WithMode(ModeDirect))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	if age := direct.Age(); age != 0 {
+		t.Errorf("Age in ModeDirect should be zero: got %v", age)
+	}
+
+	if age := Age(); age < 0 {
+		t.Errorf("Global Age is negative: %v", age)
+	}
+}
+
+func TestCachedInterval(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	earliest, latest := tc.CachedInterval()
+	now := time.Now()
+	if earliest.After(now) {
+		t.Errorf("Earliest bound is in the future: earliest=%v, now=%v", earliest, now)
+	}
+	if width := latest.Sub(earliest); width < time.Millisecond {
+		t.Errorf("Interval narrower than the resolution: %v", width)
+	}
+
+	// A lagging updater widens the interval so it still contains the true time
+	tc.Stop()
+	time.Sleep(5 * time.Millisecond)
+	earliest, latest = tc.CachedInterval()
+	now = time.Now()
+	if earliest.After(now) || latest.Before(now.Add(-time.Millisecond)) {
+		t.Errorf("Interval of a stopped cache does not cover now: [%v, %v], now=%v", earliest, latest, now)
+	}
+	if width := latest.Sub(earliest); width < 5*time.Millisecond {
+		t.Errorf("Interval of a stopped cache too narrow: %v", width)
+	}
+
+	direct, err := NewWithOptions(WithMode(ModeDirect))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	if earliest, latest := direct.CachedInterval(); !earliest.Equal(latest) {
+		t.Errorf("Interval in ModeDirect should be a point: [%v, %v]", earliest, latest)
+	}
+
+	if earliest, latest := CachedInterval(); latest.Before(earliest) {
+		t.Errorf("Global interval is inverted: [%v, %v]", earliest, latest)
+	}
+}
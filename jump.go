@@ -0,0 +1,116 @@
+// jump.go: Wall clock jump detection
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"time"
+)
+
+// jumpThreshold is the smallest disagreement between the wall and monotonic
+// clocks across one tick that is reported as a clock jump. Smaller
+// differences are measurement noise or slewing.
+const jumpThreshold = time.Millisecond
+
+// JumpDirection tells whether the wall clock was stepped forward or backward.
+type JumpDirection int
+
+const (
+	// JumpForward means the wall clock was stepped ahead, e.g. after a
+	// system suspend or an NTP correction of a late clock.
+	JumpForward JumpDirection = iota
+
+	// JumpBackward means the wall clock was stepped back, so cached
+	// timestamps went backwards.
+	JumpBackward
+)
+
+// String returns "forward" or "backward".
+func (d JumpDirection) String() string {
+	if d == JumpBackward {
+		return "backward"
+	}
+	return "forward"
+}
+
+// JumpEvent describes a wall clock jump observed by the updater.
+type JumpEvent struct {
+	// Direction tells whether the wall clock was stepped forward or backward.
+	Direction JumpDirection
+
+	// Magnitude is the size of the step, always positive.
+	Magnitude time.Duration
+
+	// Before is the cached time of the last update before the jump.
+	Before time.Time
+
+	// After is the cached time of the first update after the jump.
+	After time.Time
+}
+
+// OnClockJump sets fn to be called when the updater observes a wall clock
+// jump (NTP step, manual change, VM migration or resume from suspend),
+// replacing any previous handler; a nil fn removes it.
+//
+// Jumps are detected by comparing how far the wall and monotonic clocks
+// advanced between consecutive ticks, so they are only observed while the
+// updater runs, at most one resolution after they happen. fn is called on its
+// own goroutine and may call any method of the cache. Jumps are counted in
+// Stats whether or not a handler is set.
+//
+// Example:
+//
+//	tc.OnClockJump(func(ev timecache.JumpEvent) {
+//		log.Printf("wall clock stepped %v by %v at %v", ev.Direction, ev.Magnitude, ev.After)
+//		pipeline.FlagSince(ev.Before)
+//	})
+func (tc *TimeCache) OnClockJump(fn func(JumpEvent)) {
+	if fn == nil {
+		tc.jumpFn.Store(nil)
+		return
+	}
+	tc.jumpFn.Store(&fn)
+}
+
+// clockStep returns how much further the wall clock advanced than the
+// monotonic clock between two readings of time.Now.
+func clockStep(prev, now time.Time) time.Duration {
+	return time.Duration(now.UnixNano()-prev.UnixNano()) - now.Sub(prev)
+}
+
+// checkJump reports a clock jump between the updates at prev and now.
+func (tc *TimeCache) checkJump(prev, now time.Time) {
+	if step := clockStep(prev, now); step >= jumpThreshold || step <= -jumpThreshold {
+		tc.reportJump(prev, now, step)
+	}
+}
+
+// reportJump counts a wall clock step between the updates at prev and now and
+// dispatches it to the handler set with OnClockJump.
+func (tc *TimeCache) reportJump(prev, now time.Time, step time.Duration) {
+	tc.stats.mu.Lock()
+	tc.stats.jumps++
+	tc.stats.mu.Unlock()
+
+	fn := tc.jumpFn.Load()
+	if fn == nil {
+		return
+	}
+	ev := JumpEvent{
+		Direction: JumpForward,
+		Magnitude: step,
+		Before:    time.Unix(0, prev.UnixNano()),
+		After:     time.Unix(0, now.UnixNano()),
+	}
+	if step < 0 {
+		ev.Direction = JumpBackward
+		ev.Magnitude = -step
+	}
+
+	// The handler runs on its own goroutine so it may stop the cache, which
+	// waits for the updater to exit.
+	go (*fn)(ev)
+}
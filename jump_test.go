@@ -0,0 +1,73 @@
+// jump_test.go: Test suite for wall clock jump detection
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClockStepWithoutJump(t *testing.T) {
+	prev := time.Now()
+	time.Sleep(time.Millisecond)
+	now := time.Now()
+
+	if step := clockStep(prev, now); step >= jumpThreshold || step <= -jumpThreshold {
+		t.Errorf("Steady clocks reported as a jump: step %v", step)
+	}
+	if step := clockStep(prev, prev.Add(time.Hour)); step != 0 {
+		t.Errorf("Consistent advance reported as a step: %v", step)
+	}
+}
+
+func TestOnClockJump(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	events := make(chan JumpEvent, 1)
+	tc.OnClockJump(func(ev JumpEvent) {
+		// Stopping from the handler must not deadlock
+		tc.Stop()
+		events <- ev
+	})
+
+	prev := time.Now()
+	now := prev.Add(time.Millisecond)
+	tc.reportJump(prev, now, -5*time.Second)
+
+	select {
+	case ev := <-events:
+		if ev.Direction != JumpBackward || ev.Magnitude != 5*time.Second {
+			t.Errorf("Unexpected jump event: %+v", ev)
+		}
+		if ev.Before.UnixNano() != prev.UnixNano() || ev.After.UnixNano() != now.UnixNano() {
+			t.Errorf("Jump event times mismatch: %+v", ev)
+		}
+		if ev.Direction.String() != "backward" || JumpForward.String() != "forward" {
+			t.Errorf("JumpDirection strings mismatch: %s, %s", ev.Direction, JumpForward)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Clock jump handler was not called")
+	}
+
+	// Jumps are counted even without a handler
+	tc.OnClockJump(nil)
+	tc.reportJump(prev, now, 2*time.Second)
+	if s := tc.Stats(); s.ClockJumps != 2 {
+		t.Errorf("ClockJumps mismatch: got %d, want 2", s.ClockJumps)
+	}
+}
+
+func TestNoClockJumpsWhileSteady(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	time.Sleep(20 * time.Millisecond)
+	if s := tc.Stats(); s.ClockJumps != 0 {
+		t.Errorf("Steady clock reported %d jumps", s.ClockJumps)
+	}
+}
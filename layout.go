@@ -0,0 +1,71 @@
+// layout.go: Registry of custom pre-formatted layouts
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import "time"
+
+// LayoutHandle gives access to the cached instant rendered with a registered layout.
+// Handles are obtained from (*TimeCache).RegisterLayout and are safe for
+// concurrent use. The zero value is not usable.
+type LayoutHandle struct {
+	tc *TimeCache
+	fc *formatCache
+}
+
+// RegisterLayout registers a time.Format layout with the cache and returns a
+// handle that renders the cached instant with it.
+//
+// The layout is formatted in UTC at most once per cache update, on the first
+// read after the update, so repeated reads through the handle are
+// zero-allocation. Registering the same layout again returns a handle sharing
+// the same rendering.
+//
+// Example:
+//
+//	tc := timecache.New()
+//	defer tc.Stop()
+//	logTime := tc.RegisterLayout("2006-01-02 15:04:05.000")
+//	fmt.Printf("[%s] request served\n", logTime.String())
+func (tc *TimeCache) RegisterLayout(layout string) LayoutHandle {
+	tc.layoutsMu.Lock()
+	defer tc.layoutsMu.Unlock()
+
+	fc, ok := tc.layouts[layout]
+	if !ok {
+		fc = newFormatCache(layout, time.UTC)
+		if tc.layouts == nil {
+			tc.layouts = make(map[string]*formatCache)
+		}
+		tc.layouts[layout] = fc
+	}
+	return LayoutHandle{tc: tc, fc: fc}
+}
+
+// Layout returns the layout this handle renders.
+func (h LayoutHandle) Layout() string {
+	return h.fc.layout
+}
+
+// String returns the cached instant rendered with the handle's layout.
+//
+// Example:
+//
+//	kitchen := tc.RegisterLayout(time.Kitchen)
+//	fmt.Println(kitchen.String()) // 3:04PM
+func (h LayoutHandle) String() string {
+	return h.fc.get(h.tc.CachedTimeNano()).s
+}
+
+// Bytes returns the cached instant rendered with the handle's layout as a byte slice.
+// The slice is shared with other callers and must not be modified.
+//
+// Example:
+//
+//	buf = append(buf, prefix.Bytes()...)
+func (h LayoutHandle) Bytes() []byte {
+	return h.fc.get(h.tc.CachedTimeNano()).b
+}
@@ -0,0 +1,77 @@
+// layout_test.go: Test suite for registered layouts
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegisterLayout(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	const layout = "2006-01-02 15:04:05.000"
+	h := tc.RegisterLayout(layout)
+
+	if h.Layout() != layout {
+		t.Errorf("Layout mismatch: got %s, want %s", h.Layout(), layout)
+	}
+
+	want := time.Unix(0, tc.CachedTimeNano()).UTC().Format(layout)
+	if got := h.String(); got != want {
+		t.Errorf("String mismatch: got %s, want %s", got, want)
+	}
+	if got := string(h.Bytes()); got != want {
+		t.Errorf("Bytes mismatch: got %s, want %s", got, want)
+	}
+
+	// Registering the same layout again shares the rendering
+	again := tc.RegisterLayout(layout)
+	if again.fc != h.fc {
+		t.Error("RegisterLayout created a second cache for the same layout")
+	}
+
+	kitchen := tc.RegisterLayout(time.Kitchen)
+	if _, err := time.Parse(time.Kitchen, kitchen.String()); err != nil {
+		t.Errorf("Kitchen layout produced invalid string %q: %v", kitchen.String(), err)
+	}
+}
+
+func TestLayoutHandleZeroAlloc(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	h := tc.RegisterLayout("2006-01-02 15:04:05.000")
+	_ = h.String() // warm up the memoized rendering
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = h.String()
+		_ = h.Bytes()
+	})
+	if allocs != 0 {
+		t.Errorf("LayoutHandle allocated %v times per call, want 0", allocs)
+	}
+}
+
+func TestLayoutHandleBytesIndependent(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	h := tc.RegisterLayout("2006-01-02 15:04:05.000")
+	want := h.String()
+
+	// Appending to shared results must not write into the published rendering
+	x := append(h.Bytes(), 'A')
+	y := append(h.Bytes(), 'B')
+	if string(x) != want+"A" || string(y) != want+"B" {
+		t.Errorf("Appended results share storage: x=%q, y=%q", x, y)
+	}
+	if got := string(h.Bytes()); got != want {
+		t.Errorf("Bytes modified by an append: got %q, want %q", got, want)
+	}
+}
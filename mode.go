@@ -0,0 +1,118 @@
+// mode.go: Update modes of a TimeCache
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Mode selects how a TimeCache keeps its cached time up to date.
+// All modes expose the same API; they differ in cost and freshness.
+type Mode int
+
+const (
+	// ModeTicker updates the cached time from a background goroutine driven
+	// by a ticker at the configured resolution. This is the default mode.
+	ModeTicker Mode = iota
+
+	// ModeDirect disables caching: every read calls time.Now() and no
+	// background goroutine is started. It is meant as an escape hatch for
+	// environments where cached timestamps are undesirable.
+	ModeDirect
+
+	// ModeIdle behaves like ModeTicker but pauses the updater after a number
+	// of consecutive ticks without reads (see WithIdleTicks), so an idle
+	// process stops waking up. The first read after a pause refreshes the
+	// value inline and wakes the updater. Reads stay lock-free.
+	ModeIdle
+
+	// ModeAdaptive behaves like ModeTicker but adjusts the resolution to the
+	// read rate within the bounds set by WithAdaptiveBounds: it tightens while
+	// every tick sees reads and relaxes while ticks pass without reads.
+	// Resolution reports the current effective value.
+	ModeAdaptive
+
+	// ModeAmortized runs no background goroutine. Reads refresh the cached
+	// value inline when a monotonic clock check shows it is older than the
+	// resolution, and on every Nth read (see WithAmortizedReads); all other
+	// reads return the cached value. It suits plugins, short-lived jobs and
+	// goroutine leak checks. Start and Stop are no-ops.
+	ModeAmortized
+
+	// modeUninitialized marks the placeholder default cache before first use.
+	// Being neither plain nor a real mode, it routes global reads to loadDefault.
+	modeUninitialized Mode = -1
+)
+
+// ErrInvalidMode is returned when a Mode is unknown or cannot be parsed.
+var ErrInvalidMode = errors.New("timecache: invalid mode")
+
+// modeNames maps each Mode to its textual name used by String and ParseMode.
+var modeNames = map[Mode]string{
+	ModeTicker:    "ticker",
+	ModeDirect:    "direct",
+	ModeIdle:      "idle",
+	ModeAdaptive:  "adaptive",
+	ModeAmortized: "amortized",
+}
+
+// String returns the name of the mode, e.g. "ticker".
+func (m Mode) String() string {
+	if name, ok := modeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// ParseMode parses a mode name as returned by Mode.String.
+// Matching is case-insensitive and ignores surrounding spaces.
+//
+// Example:
+//
+//	mode, err := timecache.ParseMode("direct")
+func ParseMode(s string) (Mode, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for m, n := range modeNames {
+		if n == name {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrInvalidMode, s)
+}
+
+// WithMode selects how the cache is kept up to date. The default is ModeTicker.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(timecache.WithMode(timecache.ModeDirect))
+func WithMode(mode Mode) Option {
+	return func(c *config) error {
+		if _, ok := modeNames[mode]; !ok {
+			return fmt.Errorf("%w: %v", ErrInvalidMode, mode)
+		}
+		c.mode = mode
+		return nil
+	}
+}
+
+// updater reports whether caches in mode m run a background updater goroutine.
+func (m Mode) updater() bool {
+	return m != ModeDirect && m != ModeAmortized
+}
+
+// Mode returns the mode the cache was created with.
+//
+// Example:
+//
+//	if tc.Mode() == timecache.ModeDirect {
+//		log.Println("time caching disabled")
+//	}
+func (tc *TimeCache) Mode() Mode {
+	return tc.mode
+}
@@ -0,0 +1,72 @@
+// mode_test.go: Test suite for update modes
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseMode(t *testing.T) {
+	for mode, name := range modeNames {
+		if mode.String() != name {
+			t.Errorf("String mismatch: got %s, want %s", mode.String(), name)
+		}
+		parsed, err := ParseMode(" " + name + " ")
+		if err != nil || parsed != mode {
+			t.Errorf("ParseMode(%q) = %v, %v; want %v", name, parsed, err, mode)
+		}
+	}
+
+	if _, err := ParseMode("bogus"); !errors.Is(err, ErrInvalidMode) {
+		t.Errorf("ParseMode(bogus) error mismatch: got %v", err)
+	}
+	if _, err := NewWithOptions(WithMode(Mode(99))); !errors.Is(err, ErrInvalidMode) {
+		t.Errorf("WithMode(99) error mismatch: got %v", err)
+	}
+	if got := Mode(99).String(); got != "Mode(99)" {
+		t.Errorf("Unknown mode String mismatch: got %s", got)
+	}
+}
+
+func TestModeDirect(t *testing.T) {
+	tc, err := NewWithOptions(WithMode(ModeDirect))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Mode() != ModeDirect {
+		t.Errorf("Mode mismatch: got %v, want %v", tc.Mode(), ModeDirect)
+	}
+
+	// No updater runs in direct mode
+	select {
+	case <-tc.Done():
+	default:
+		t.Error("Done not closed for a direct mode cache")
+	}
+
+	// Every read reflects the real clock
+	first := tc.CachedTimeNano()
+	time.Sleep(time.Millisecond)
+	second := tc.CachedTimeNano()
+	if second-first < int64(time.Millisecond) {
+		t.Errorf("Direct mode read did not follow the clock: first=%d, second=%d", first, second)
+	}
+
+	start := tc.CachedMonotonic()
+	time.Sleep(time.Millisecond)
+	if elapsed := tc.Since(start); elapsed < time.Millisecond {
+		t.Errorf("Direct mode Since too small: %v", elapsed)
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, tc.CachedTimeString()); err != nil {
+		t.Errorf("Direct mode CachedTimeString invalid: %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+// nondecreasing.go: Non-decreasing cached wall time
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// WithNonDecreasing makes the cache never publish a time earlier than one it
+// has already published, so CachedTime, CachedTimeNano, CachedTimeString and
+// every other read never go backwards, even across a backward wall clock step.
+//
+// After a backward step the cached time slews: it advances at half the speed
+// of the monotonic clock until the wall clock catches up, instead of stopping
+// dead or jumping back. Published values may therefore lag behind the wall
+// clock by up to the size of the step. Clamped updates are counted in
+// Stats().ClampedUpdates. It applies to all modes, including ModeDirect.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(timecache.WithNonDecreasing())
+func WithNonDecreasing() Option {
+	return func(c *config) error {
+		c.nonDecreasing = true
+		return nil
+	}
+}
+
+// advance publishes now unless it is earlier than the cached time, in which
+// case it publishes the cached time slewed by half the monotonic time elapsed
+// since it was published. It returns the cached time after the update.
+// Writers holding a reading older than the last update leave it unchanged.
+func (tc *TimeCache) advance(now time.Time) int64 {
+	wall := now.UnixNano()
+	monoNow := int64(now.Sub(tc.epoch))
+	for {
+		mono := atomic.LoadInt64(&tc.cachedMonoNano)
+		last := atomic.LoadInt64(&tc.cachedTimeNano)
+		if monoNow < mono {
+			return last
+		}
+
+		next := wall
+		clamped := next < last
+		if clamped {
+			next = last + (monoNow-mono)/2
+		}
+		if atomic.CompareAndSwapInt64(&tc.cachedTimeNano, last, next) {
+			atomic.StoreInt64(&tc.cachedMonoNano, monoNow)
+			if clamped {
+				atomic.AddUint64(&tc.stats.clamped, 1)
+			}
+			return next
+		}
+	}
+}
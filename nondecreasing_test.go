@@ -0,0 +1,120 @@
+// nondecreasing_test.go: Test suite for the non-decreasing cached wall time
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stepBack simulates a backward wall clock step of d by moving the cached
+// time ahead of the wall clock.
+func stepBack(tc *TimeCache, d time.Duration) int64 {
+	ahead := time.Now().Add(d)
+	atomic.StoreInt64(&tc.cachedTimeNano, ahead.UnixNano())
+	atomic.StoreInt64(&tc.cachedMonoNano, int64(ahead.Sub(tc.epoch))-int64(d))
+	return ahead.UnixNano()
+}
+
+func TestNonDecreasingSlews(t *testing.T) {
+	tc, err := NewWithOptions(WithNonDecreasing(), WithResolution(time.Hour))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	start := time.Now()
+	ahead := stepBack(tc, time.Second)
+	time.Sleep(10 * time.Millisecond)
+
+	// The update is clamped and slews at half speed instead of going back
+	got := tc.store(time.Now())
+	if got < ahead {
+		t.Fatalf("Cached time went backwards: got %d, want >= %d", got, ahead)
+	}
+	if slew, elapsed := time.Duration(got-ahead), time.Since(start); slew < 5*time.Millisecond || slew > elapsed/2 {
+		t.Errorf("Slew out of range: got %v, want half of %v", slew, elapsed)
+	}
+	if s := tc.Stats(); s.ClampedUpdates != 1 {
+		t.Errorf("ClampedUpdates mismatch: got %d, want 1", s.ClampedUpdates)
+	}
+
+	// Once the wall clock catches up it is published unchanged again
+	atomic.StoreInt64(&tc.cachedTimeNano, time.Now().Add(-time.Second).UnixNano())
+	now := time.Now()
+	if got := tc.store(now); got != now.UnixNano() {
+		t.Errorf("Wall clock ahead of the cache was clamped: got %d, want %d", got, now.UnixNano())
+	}
+
+	// A stale reading never overwrites a newer update
+	if got := tc.store(now.Add(-time.Millisecond)); got != now.UnixNano() {
+		t.Errorf("Stale reading was published: got %d, want %d", got, now.UnixNano())
+	}
+}
+
+func TestNonDecreasingModeDirect(t *testing.T) {
+	tc, err := NewWithOptions(WithNonDecreasing(), WithMode(ModeDirect))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+
+	ahead := stepBack(tc, time.Second)
+
+	// Every read API sees the same non-decreasing time
+	prev := ahead
+	for i := 0; i < 100; i++ {
+		got := tc.CachedTimeNano()
+		if got < prev {
+			t.Fatalf("Read %d went backwards: got %d, prev %d", i, got, prev)
+		}
+		prev = got
+	}
+	if got := tc.CachedTime().UnixNano(); got < prev {
+		t.Errorf("CachedTime went backwards: got %d, prev %d", got, prev)
+	}
+	if got, _ := time.Parse(time.RFC3339Nano, tc.CachedTimeString()); got.UnixNano() < ahead {
+		t.Errorf("CachedTimeString went backwards: got %v", got)
+	}
+	if tc.Stats().ClampedUpdates == 0 {
+		t.Error("Clamped reads were not counted")
+	}
+}
+
+func TestNonDecreasingConcurrent(t *testing.T) {
+	tc, err := NewWithOptions(
+		WithNonDecreasing(),
+		WithMode(ModeAmortized),
+		WithAmortizedReads(1),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// Concurrent inline refreshers must never observe a regression
+	errs := make(chan int64, 4)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			prev := int64(0)
+			for j := 0; j < 10000; j++ {
+				got := tc.CachedTimeNano()
+				if got < prev {
+					errs <- prev - got
+					return
+				}
+				prev = got
+			}
+			errs <- 0
+		}()
+	}
+	for i := 0; i < cap(errs); i++ {
+		if back := <-errs; back != 0 {
+			t.Errorf("Concurrent reads went backwards by %dns", back)
+		}
+	}
+}
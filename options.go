@@ -0,0 +1,175 @@
+// options.go: Options-based construction and validation
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Resolution bounds and default used by NewWithOptions.
+const (
+	// DefaultResolution is the resolution used by New and the default cache.
+	DefaultResolution = 500 * time.Microsecond
+
+	// MinResolution is the smallest accepted resolution. Finer values would
+	// make the updater more expensive than calling time.Now() directly.
+	MinResolution = time.Microsecond
+
+	// MaxResolution is the largest accepted resolution.
+	MaxResolution = time.Hour
+)
+
+// Errors returned by NewWithOptions. Returned errors wrap these sentinels with
+// details and can be matched with errors.Is.
+var (
+	// ErrInvalidResolution is returned when a resolution is outside
+	// [MinResolution, MaxResolution].
+	ErrInvalidResolution = errors.New("timecache: invalid resolution")
+
+	// ErrNilContext is returned when WithContext is given a nil context.
+	ErrNilContext = errors.New("timecache: nil context")
+)
+
+// Option configures a TimeCache created with NewWithOptions.
+// Options validate their arguments and report problems as errors
+// from NewWithOptions instead of panicking.
+type Option func(*config) error
+
+// config holds the settings collected from Options.
+type config struct {
+	ctx        context.Context
+	resolution time.Duration
+	mode       Mode
+	idleTicks  int
+
+	adaptiveMin time.Duration
+	adaptiveMax time.Duration
+
+	maxStaleness time.Duration
+
+	amortizedReads int
+
+	nonDecreasing bool
+
+	watchdogThreshold time.Duration
+	watchdogFn        func(StallEvent)
+}
+
+// defaultConfig returns the settings used when no Option overrides them.
+func defaultConfig() config {
+	return config{
+		ctx:        context.Background(),
+		resolution: DefaultResolution,
+		mode:       ModeTicker,
+		idleTicks:  DefaultIdleTicks,
+
+		adaptiveMin: DefaultAdaptiveMin,
+		adaptiveMax: DefaultAdaptiveMax,
+
+		amortizedReads: DefaultAmortizedReads,
+	}
+}
+
+// WithResolution sets how frequently the cached time is updated.
+// It must be within [MinResolution, MaxResolution]. The default is DefaultResolution.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(timecache.WithResolution(time.Millisecond))
+func WithResolution(resolution time.Duration) Option {
+	return func(c *config) error {
+		if err := validateResolution(resolution); err != nil {
+			return err
+		}
+		c.resolution = resolution
+		return nil
+	}
+}
+
+// withLegacyResolution sets the resolution for the constructors that predate
+// NewWithOptions. They keep accepting any positive resolution, as they did
+// before the bounds were introduced, and only reject what time.NewTicker rejects.
+func withLegacyResolution(resolution time.Duration) Option {
+	return func(c *config) error {
+		if resolution <= 0 {
+			return fmt.Errorf("%w: %v is not positive", ErrInvalidResolution, resolution)
+		}
+		c.resolution = resolution
+		return nil
+	}
+}
+
+// WithContext binds the background updater to ctx, as NewWithContext does.
+// The default is context.Background().
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(timecache.WithContext(ctx))
+func WithContext(ctx context.Context) Option {
+	return func(c *config) error {
+		if ctx == nil {
+			return ErrNilContext
+		}
+		c.ctx = ctx
+		return nil
+	}
+}
+
+// validateResolution reports whether resolution is within the accepted bounds.
+func validateResolution(resolution time.Duration) error {
+	switch {
+	case resolution < MinResolution:
+		return fmt.Errorf("%w: %v is below the minimum of %v", ErrInvalidResolution, resolution, MinResolution)
+	case resolution > MaxResolution:
+		return fmt.Errorf("%w: %v is above the maximum of %v", ErrInvalidResolution, resolution, MaxResolution)
+	}
+	return nil
+}
+
+// NewWithOptions creates a new TimeCache configured by opts.
+//
+// Options are applied in order and validated; the first invalid option is
+// reported as an error and no cache or goroutine is created. Without options
+// the cache behaves like one created with New. The cache starts updating
+// immediately and must be stopped explicitly to prevent goroutine leaks.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(
+//		timecache.WithResolution(time.Millisecond),
+//		timecache.WithContext(ctx),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	defer tc.Stop()
+func NewWithOptions(opts ...Option) (*TimeCache, error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.mode == ModeAdaptive {
+		// Start from the configured resolution, kept within the adaptive bounds.
+		cfg.resolution = clampResolution(cfg.resolution, cfg.adaptiveMin, cfg.adaptiveMax)
+	}
+	return newTimeCache(cfg), nil
+}
+
+// mustNew creates a TimeCache from opts, panicking on invalid options.
+// It backs the constructors that predate NewWithOptions and cannot return errors.
+func mustNew(opts ...Option) *TimeCache {
+	tc, err := NewWithOptions(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return tc
+}
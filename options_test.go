@@ -0,0 +1,105 @@
+// options_test.go: Test suite for options-based construction
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewWithOptionsDefaults(t *testing.T) {
+	tc, err := NewWithOptions()
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Resolution() != DefaultResolution {
+		t.Errorf("Default resolution mismatch: got %v, want %v", tc.Resolution(), DefaultResolution)
+	}
+	if tc.CachedTimeNano() == 0 {
+		t.Error("NewWithOptions cache returned zero timestamp")
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tc, err := NewWithOptions(WithResolution(2*time.Millisecond), WithContext(ctx))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.Resolution() != 2*time.Millisecond {
+		t.Errorf("Resolution mismatch: got %v, want %v", tc.Resolution(), 2*time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-tc.Done():
+	case <-time.After(time.Second):
+		t.Error("Updater did not exit after WithContext context was cancelled")
+	}
+}
+
+func TestNewWithOptionsValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+		want error
+	}{
+		{"zero resolution", WithResolution(0), ErrInvalidResolution},
+		{"negative resolution", WithResolution(-time.Millisecond), ErrInvalidResolution},
+		{"resolution below minimum", WithResolution(MinResolution - 1), ErrInvalidResolution},
+		{"resolution above maximum", WithResolution(MaxResolution + 1), ErrInvalidResolution},
+		{"nil context", WithContext(nil), ErrNilContext}, //nolint:staticcheck // nil context is the case under test
+	}
+
+	for _, tt := range tests {
+		tc, err := NewWithOptions(tt.opt)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.want)
+		}
+		if tc != nil {
+			t.Errorf("%s: returned a cache alongside an error", tt.name)
+			tc.Stop()
+		}
+	}
+}
+
+func TestLegacyConstructorsAcceptAnyPositiveResolution(t *testing.T) {
+	// Values outside [MinResolution, MaxResolution] predate the bounds and stay valid
+	for _, resolution := range []time.Duration{500 * time.Nanosecond, 2 * time.Hour} {
+		tc := NewWithResolution(resolution)
+		if got := tc.Resolution(); got != resolution {
+			t.Errorf("NewWithResolution(%v) resolution mismatch: got %v", resolution, got)
+		}
+		tc.Stop()
+
+		tc = NewWithContext(context.Background(), resolution)
+		if got := tc.Resolution(); got != resolution {
+			t.Errorf("NewWithContext(%v) resolution mismatch: got %v", resolution, got)
+		}
+		tc.Stop()
+
+		if _, err := NewWithOptions(WithResolution(resolution)); !errors.Is(err, ErrInvalidResolution) {
+			t.Errorf("NewWithOptions(WithResolution(%v)) error mismatch: got %v", resolution, err)
+		}
+	}
+}
+
+func TestNewWithResolutionPanicsOnInvalid(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrInvalidResolution) {
+			t.Errorf("NewWithResolution(0) panic mismatch: got %v", err)
+		}
+	}()
+	NewWithResolution(0)
+}
@@ -0,0 +1,79 @@
+// presets.go: Cached syslog and Common Log Format timestamps
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+// Layouts used by the timestamp presets. They can also be passed to
+// RegisterLayout or AppendCachedTime.
+const (
+	// SyslogRFC5424Layout is the RFC 5424 TIMESTAMP with microsecond precision,
+	// e.g. "2006-01-02T15:04:05.000000Z".
+	SyslogRFC5424Layout = "2006-01-02T15:04:05.000000Z07:00"
+
+	// SyslogRFC3164Layout is the BSD syslog TIMESTAMP, e.g. "Jan  2 15:04:05".
+	SyslogRFC3164Layout = "Jan _2 15:04:05"
+
+	// CLFLayout is the Apache/NGINX Common Log Format timestamp without the
+	// surrounding brackets, e.g. "02/Jan/2006:15:04:05 +0000".
+	CLFLayout = "02/Jan/2006:15:04:05 -0700"
+)
+
+// SyslogRFC5424 returns the cached time as an RFC 5424 syslog timestamp in UTC,
+// e.g. "2025-01-02T15:04:05.123456Z".
+//
+// The string is rendered at most once per cache update and shared by all
+// callers, so repeated reads are zero-allocation.
+//
+// Example:
+//
+//	fmt.Fprintf(w, "<%d>1 %s %s %s - - - %s\n", pri, tc.SyslogRFC5424(), host, app, msg)
+func (tc *TimeCache) SyslogRFC5424() string {
+	return tc.syslog5424.get(tc.CachedTimeNano()).s
+}
+
+// SyslogRFC3164 returns the cached time as a BSD (RFC 3164) syslog timestamp
+// in UTC, e.g. "Jan  2 15:04:05".
+//
+// The layout has second precision, so the string is rendered at most once per
+// second and shared by all callers.
+//
+// Example:
+//
+//	fmt.Fprintf(w, "<%d>%s %s %s: %s\n", pri, tc.SyslogRFC3164(), host, tag, msg)
+func (tc *TimeCache) SyslogRFC3164() string {
+	return tc.syslog3164.get(tc.CachedTimeNano()).s
+}
+
+// CLFTime returns the cached time as a Common Log Format timestamp in UTC,
+// e.g. "02/Jan/2025:15:04:05 +0000", without the surrounding brackets.
+//
+// The layout has second precision, so the string is rendered at most once per
+// second and shared by all callers.
+//
+// Example:
+//
+//	fmt.Fprintf(w, "%s - - [%s] %q %d %d\n", ip, tc.CLFTime(), line, status, size)
+func (tc *TimeCache) CLFTime() string {
+	return tc.clf.get(tc.CachedTimeNano()).s
+}
+
+// SyslogRFC5424 returns the cached time from the default cache as an RFC 5424
+// syslog timestamp. See (*TimeCache).SyslogRFC5424 for details.
+func SyslogRFC5424() string {
+	return loadDefault().SyslogRFC5424()
+}
+
+// SyslogRFC3164 returns the cached time from the default cache as a BSD
+// syslog timestamp. See (*TimeCache).SyslogRFC3164 for details.
+func SyslogRFC3164() string {
+	return loadDefault().SyslogRFC3164()
+}
+
+// CLFTime returns the cached time from the default cache as a Common Log
+// Format timestamp. See (*TimeCache).CLFTime for details.
+func CLFTime() string {
+	return loadDefault().CLFTime()
+}
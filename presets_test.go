@@ -0,0 +1,67 @@
+// presets_test.go: Test suite for syslog and Common Log Format presets
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPresetLayouts(t *testing.T) {
+	instant := time.Date(2025, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{SyslogRFC5424Layout, "2025-01-02T03:04:05.123456Z"},
+		{SyslogRFC3164Layout, "Jan  2 03:04:05"},
+		{CLFLayout, "02/Jan/2025:03:04:05 +0000"},
+	}
+
+	for _, tt := range tests {
+		if got := instant.Format(tt.layout); got != tt.want {
+			t.Errorf("Layout %q mismatch: got %s, want %s", tt.layout, got, tt.want)
+		}
+	}
+}
+
+func TestPresets(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	now := time.Unix(0, tc.CachedTimeNano()).UTC()
+
+	if got, want := tc.SyslogRFC5424(), now.Format(SyslogRFC5424Layout); got != want {
+		t.Errorf("SyslogRFC5424 mismatch: got %s, want %s", got, want)
+	}
+	if got, want := tc.SyslogRFC3164(), now.Format(SyslogRFC3164Layout); got != want {
+		t.Errorf("SyslogRFC3164 mismatch: got %s, want %s", got, want)
+	}
+	if got, want := tc.CLFTime(), now.Format(CLFLayout); got != want {
+		t.Errorf("CLFTime mismatch: got %s, want %s", got, want)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = tc.SyslogRFC5424()
+		_ = tc.SyslogRFC3164()
+		_ = tc.CLFTime()
+	})
+	if allocs != 0 {
+		t.Errorf("Presets allocated %v times per call, want 0", allocs)
+	}
+
+	if _, err := time.Parse(SyslogRFC5424Layout, SyslogRFC5424()); err != nil {
+		t.Errorf("Global SyslogRFC5424 invalid: %v", err)
+	}
+	if _, err := time.Parse(SyslogRFC3164Layout, SyslogRFC3164()); err != nil {
+		t.Errorf("Global SyslogRFC3164 invalid: %v", err)
+	}
+	if _, err := time.Parse(CLFLayout, CLFTime()); err != nil {
+		t.Errorf("Global CLFTime invalid: %v", err)
+	}
+}
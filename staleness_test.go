@@ -0,0 +1,110 @@
+// staleness_test.go: Test suite for staleness-bounded reads
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMaxStalenessValidation(t *testing.T) {
+	if _, err := NewWithOptions(WithMaxStaleness(0)); !errors.Is(err, ErrInvalidStaleness) {
+		t.Errorf("WithMaxStaleness(0) error mismatch: got %v", err)
+	}
+}
+
+func TestCachedTimeNanoBounded(t *testing.T) {
+	// An hourly updater simulates a starved goroutine
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	stale := tc.CachedTimeNano()
+	time.Sleep(3 * time.Millisecond)
+
+	// A generous bound accepts the cached value
+	if got := tc.CachedTimeNanoBounded(time.Minute); got != stale {
+		t.Errorf("Bounded read refreshed a fresh enough value: got %d, want %d", got, stale)
+	}
+
+	// A tight bound forces an inline refresh that is published for everyone
+	before := time.Now().UnixNano()
+	fresh := tc.CachedTimeNanoBounded(time.Millisecond)
+	if fresh < before {
+		t.Errorf("Bounded read returned a stale value: got %d, want >= %d", fresh, before)
+	}
+	if got := tc.CachedTimeNano(); got != fresh {
+		t.Errorf("Inline refresh not published: got %d, want %d", got, fresh)
+	}
+
+	if got := CachedTimeNanoBounded(time.Minute); got == 0 {
+		t.Error("Global CachedTimeNanoBounded returned zero timestamp")
+	}
+}
+
+func TestWithMaxStaleness(t *testing.T) {
+	tc, err := NewWithOptions(WithResolution(time.Hour), WithMaxStaleness(time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if tc.plain {
+		t.Fatal("Cache with a staleness bound uses the plain read path")
+	}
+
+	stale := atomic.LoadInt64(&tc.cachedTimeNano)
+	time.Sleep(3 * time.Millisecond)
+
+	before := time.Now().UnixNano()
+	if got := tc.CachedTimeNano(); got < before || got == stale {
+		t.Errorf("CachedTimeNano exceeded the staleness bound: got %d, stale %d, before %d", got, stale, before)
+	}
+
+	// Monotonic and formatted reads honour the bound too
+	time.Sleep(3 * time.Millisecond)
+	if age := time.Since(tc.CachedMonotonic()); age > 2*time.Millisecond {
+		t.Errorf("CachedMonotonic exceeded the staleness bound: age %v", age)
+	}
+}
+
+func TestCachedTimeNanoBoundedWithMaxStaleness(t *testing.T) {
+	tc, err := NewWithOptions(WithResolution(time.Hour), WithMaxStaleness(time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// The tighter configured bound wins over a generous per-read bound
+	time.Sleep(3 * time.Millisecond)
+	before := time.Now().UnixNano()
+	if got := tc.CachedTimeNanoBounded(time.Minute); got < before {
+		t.Errorf("Bounded read ignored the configured staleness bound: got %d, want >= %d", got, before)
+	}
+}
+
+func TestBoundedNanoConcurrentRefresh(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+	time.Sleep(2 * time.Millisecond)
+
+	// Concurrent refreshers must all get fresh values and publish one of them
+	results := make(chan int64, 8)
+	before := time.Now().UnixNano()
+	for i := 0; i < cap(results); i++ {
+		go func() { results <- tc.CachedTimeNanoBounded(time.Millisecond) }()
+	}
+	for i := 0; i < cap(results); i++ {
+		if got := <-results; got < before {
+			t.Errorf("Concurrent bounded read returned a stale value: got %d, want >= %d", got, before)
+		}
+	}
+	if got := tc.CachedTimeNano(); got < before {
+		t.Errorf("No refresh was published: got %d, want >= %d", got, before)
+	}
+}
@@ -0,0 +1,292 @@
+// stats.go: Updater health metrics and stall watchdog
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// statsWindow is the number of recent update intervals kept for the
+// percentiles reported by Stats.
+const statsWindow = 256
+
+// ErrInvalidWatchdog is returned when WithWatchdog is given a non-positive
+// threshold or a nil callback.
+var ErrInvalidWatchdog = errors.New("timecache: invalid watchdog")
+
+// Stats is a snapshot of the health of a cache's background updater.
+// Interval figures only cover ticks of the updater: inline refreshes by
+// readers and the time the updater was stopped or paused are not counted.
+type Stats struct {
+	// Ticks is the number of updates performed by the updater.
+	Ticks uint64
+
+	// MissedTicks is the number of ticks the updater did not keep up with.
+	// A slow updater does not queue ticks; they are coalesced into the next
+	// update, which then arrives late.
+	MissedTicks uint64
+
+	// IntervalP50, IntervalP90 and IntervalP99 are percentiles of the time
+	// between consecutive updates over the most recent ticks.
+	IntervalP50 time.Duration
+	IntervalP90 time.Duration
+	IntervalP99 time.Duration
+
+	// MaxLag is the longest time observed between consecutive updates.
+	MaxLag time.Duration
+
+	// ClockJumps is the number of wall clock jumps observed by the updater
+	// (see OnClockJump).
+	ClockJumps uint64
+
+	// ClampedUpdates is the number of updates held back by WithNonDecreasing
+	// because the wall clock was behind the cached time.
+	ClampedUpdates uint64
+
+	// Stalls is the number of stalls reported by the watchdog (see WithWatchdog).
+	Stalls uint64
+
+	// LastUpdate is the currently cached time, i.e. the time of the last update.
+	LastUpdate time.Time
+}
+
+// StallEvent describes a stall detected by the watchdog.
+type StallEvent struct {
+	// Lag is the time elapsed since the updater's last tick when the stall
+	// was detected.
+	Lag time.Duration
+
+	// LastTick is the time of the updater's last tick.
+	LastTick time.Time
+
+	// Resolution is the resolution of the cache at detection.
+	Resolution time.Duration
+}
+
+// updaterStats collects the metrics reported by Stats. It is written by the
+// updater on every tick and read by Stats, so its mutex is rarely contended.
+type updaterStats struct {
+	mu     sync.Mutex
+	ticks  uint64
+	missed uint64
+	stalls uint64
+	jumps  uint64
+	maxLag time.Duration
+
+	// last is the time of the previous update; it is reset on (re)start and
+	// after a pause so that downtime does not count as lag.
+	last time.Time
+
+	// resumed is non-nil while a ModeIdle updater is deliberately paused,
+	// and is closed when it resumes.
+	resumed chan struct{}
+
+	// intervals is a ring buffer of the most recent update intervals,
+	// indexed by ticks.
+	intervals [statsWindow]time.Duration
+
+	// clamped counts updates held back by WithNonDecreasing. It is accessed
+	// atomically without mu because readers update it on inline refreshes.
+	clamped uint64
+}
+
+// WithWatchdog starts a watchdog alongside the updater that calls fn when the
+// updater has not ticked for longer than threshold, e.g. because its
+// goroutine is starved under load. The updater itself is watched, not the
+// cached value, so inline refreshes by readers (WithMaxStaleness, ModeIdle)
+// do not hide a stalled updater. fn is called once per stall,
+// on its own goroutine, and may call any method of the cache including Stop
+// and Restart. A stall is reported again only after updates resume.
+//
+// The threshold should comfortably exceed the resolution (in ModeAdaptive,
+// the maximum resolution). A paused ModeIdle updater is not a stall, and the
+// watchdog sleeps until it resumes. The watchdog has no effect in modes
+// without an updater.
+//
+// Example:
+//
+//	tc, err := timecache.NewWithOptions(
+//		timecache.WithWatchdog(50*time.Millisecond, func(ev timecache.StallEvent) {
+//			log.Printf("timecache stalled: last tick %v ago", ev.Lag)
+//		}),
+//	)
+func WithWatchdog(threshold time.Duration, fn func(StallEvent)) Option {
+	return func(c *config) error {
+		if threshold <= 0 {
+			return fmt.Errorf("%w: threshold %v", ErrInvalidWatchdog, threshold)
+		}
+		if fn == nil {
+			return fmt.Errorf("%w: nil callback", ErrInvalidWatchdog)
+		}
+		c.watchdogThreshold = threshold
+		c.watchdogFn = fn
+		return nil
+	}
+}
+
+// Stats returns a snapshot of the health of the background updater.
+// It is meant for metrics and debugging and allocates; it does not count
+// as a read.
+//
+// Example:
+//
+//	s := tc.Stats()
+//	if s.MissedTicks > 0 {
+//		log.Printf("timecache missed %d ticks, p99 interval %v", s.MissedTicks, s.IntervalP99)
+//	}
+func (tc *TimeCache) Stats() Stats {
+	st := &tc.stats
+	st.mu.Lock()
+	s := Stats{
+		Ticks:       st.ticks,
+		MissedTicks: st.missed,
+		MaxLag:      st.maxLag,
+		Stalls:      st.stalls,
+		ClockJumps:  st.jumps,
+	}
+	s.ClampedUpdates = atomic.LoadUint64(&st.clamped)
+	intervals := slices.Clone(st.intervals[:min(st.ticks, statsWindow)])
+	st.mu.Unlock()
+
+	s.LastUpdate = time.Unix(0, atomic.LoadInt64(&tc.cachedTimeNano))
+	if len(intervals) > 0 {
+		slices.Sort(intervals)
+		s.IntervalP50 = percentile(intervals, 50)
+		s.IntervalP90 = percentile(intervals, 90)
+		s.IntervalP99 = percentile(intervals, 99)
+	}
+	return s
+}
+
+// percentile returns the nearest-rank p-th percentile of the sorted intervals.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (len(sorted)*p + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// DefaultStats returns a snapshot of the health of the default cache's updater.
+// See (*TimeCache).Stats for details.
+//
+// Example:
+//
+//	s := timecache.DefaultStats()
+func DefaultStats() Stats {
+	return loadDefault().Stats()
+}
+
+// resume sets now as the baseline of the next update interval and marks
+// the updater as ticking.
+func (st *updaterStats) resume(now time.Time) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.last = now
+	if st.resumed != nil {
+		close(st.resumed)
+		st.resumed = nil
+	}
+}
+
+// pause marks the updater as deliberately paused, which is not a stall.
+func (st *updaterStats) pause() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.resumed == nil {
+		st.resumed = make(chan struct{})
+	}
+}
+
+// lastTick returns the time of the updater's last tick and whether it is
+// expected to keep ticking, i.e. it has started and is not paused. While the
+// updater is paused, resumed is closed when it resumes.
+func (st *updaterStats) lastTick() (last time.Time, ticking bool, resumed <-chan struct{}) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.last, st.resumed == nil && !st.last.IsZero(), st.resumed
+}
+
+// tick records an update of the updater at now.
+func (st *updaterStats) tick(now time.Time, resolution time.Duration) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if !st.last.IsZero() {
+		interval := now.Sub(st.last)
+		st.intervals[st.ticks%statsWindow] = interval
+		st.maxLag = max(st.maxLag, interval)
+
+		// Intervals spanning several periods mean ticks were coalesced.
+		if periods := (interval + resolution/2) / resolution; periods > 1 {
+			st.missed += uint64(periods - 1)
+		}
+	}
+	st.ticks++
+	st.last = now
+}
+
+// watch runs the watchdog until stop is closed or the cache context is done,
+// closing done on exit.
+func (tc *TimeCache) watch(stop chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	period := max(tc.watchdogThreshold/2, MinResolution)
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	stalled := false
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		case <-tc.ctx.Done():
+			return
+		}
+
+		last, ticking, resumed := tc.stats.lastTick()
+		if resumed != nil {
+			// A paused updater cannot stall: sleep until it resumes instead
+			// of waking the process while it is idle.
+			ticker.Stop()
+			select {
+			case <-resumed:
+			case <-stop:
+				return
+			case <-tc.ctx.Done():
+				return
+			}
+			ticker.Reset(period)
+			stalled = false
+			continue
+		}
+		lag := time.Since(last)
+		if !ticking || lag <= tc.watchdogThreshold {
+			stalled = false
+			continue
+		}
+		if stalled {
+			continue
+		}
+		stalled = true
+
+		tc.stats.mu.Lock()
+		tc.stats.stalls++
+		tc.stats.mu.Unlock()
+
+		// The callback runs on its own goroutine so it may stop the cache,
+		// which waits for this watchdog to exit.
+		go tc.watchdogFn(StallEvent{
+			Lag:        lag,
+			LastTick:   time.Unix(0, last.UnixNano()),
+			Resolution: tc.Resolution(),
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// stats_test.go: Test suite for updater health metrics and the stall watchdog
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	time.Sleep(30 * time.Millisecond)
+	s := tc.Stats()
+
+	if s.Ticks == 0 {
+		t.Fatal("Stats reported no ticks")
+	}
+	if s.IntervalP50 <= 0 || s.IntervalP50 > s.IntervalP90 || s.IntervalP90 > s.IntervalP99 || s.IntervalP99 > s.MaxLag {
+		t.Errorf("Inconsistent interval figures: p50=%v, p90=%v, p99=%v, max=%v",
+			s.IntervalP50, s.IntervalP90, s.IntervalP99, s.MaxLag)
+	}
+	if s.LastUpdate.IsZero() || time.Since(s.LastUpdate) > time.Second {
+		t.Errorf("LastUpdate out of range: %v", s.LastUpdate)
+	}
+
+	if DefaultStats().LastUpdate.IsZero() {
+		t.Error("DefaultStats returned zero LastUpdate")
+	}
+}
+
+func TestStatsIgnoresDowntime(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	time.Sleep(5 * time.Millisecond)
+	tc.Stop()
+	time.Sleep(100 * time.Millisecond)
+	tc.Start()
+	time.Sleep(5 * time.Millisecond)
+
+	if s := tc.Stats(); s.MaxLag >= 100*time.Millisecond {
+		t.Errorf("Stopped period counted as lag: %v", s.MaxLag)
+	}
+}
+
+func TestStatsMissedTicks(t *testing.T) {
+	var st updaterStats
+	start := time.Now()
+	resolution := time.Millisecond
+
+	st.resume(start)
+	st.tick(start.Add(resolution), resolution)
+	st.tick(start.Add(5*resolution), resolution) // three ticks coalesced
+	st.tick(start.Add(6*resolution), resolution)
+
+	if st.ticks != 3 || st.missed != 3 || st.maxLag != 4*resolution {
+		t.Errorf("Tick accounting mismatch: ticks=%d, missed=%d, maxLag=%v", st.ticks, st.missed, st.maxLag)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		p    int
+		want time.Duration
+	}{
+		{0, 1},
+		{50, 5},
+		{90, 9},
+		{99, 10},
+		{100, 10},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); got != tt.want {
+			t.Errorf("percentile(%d) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestWithWatchdogValidation(t *testing.T) {
+	if _, err := NewWithOptions(WithWatchdog(0, func(StallEvent) {})); !errors.Is(err, ErrInvalidWatchdog) {
+		t.Errorf("WithWatchdog(0) error mismatch: got %v", err)
+	}
+	if _, err := NewWithOptions(WithWatchdog(time.Second, nil)); !errors.Is(err, ErrInvalidWatchdog) {
+		t.Errorf("WithWatchdog(nil) error mismatch: got %v", err)
+	}
+}
+
+func TestWatchdogReportsStall(t *testing.T) {
+	events := make(chan StallEvent, 1)
+	caches := make(chan *TimeCache, 1)
+	tc, err := NewWithOptions(
+		WithResolution(time.Millisecond),
+		WithWatchdog(10*time.Millisecond, func(ev StallEvent) {
+			// Stopping from the callback must not deadlock
+			(<-caches).Stop()
+			events <- ev
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+	caches <- tc
+
+	// A healthy updater is not reported
+	select {
+	case ev := <-events:
+		t.Fatalf("Healthy updater reported as stalled: %+v", ev)
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	// An hourly ticker freezes the value like a starved updater would
+	if err := tc.SetResolution(time.Hour); err != nil {
+		t.Fatalf("SetResolution returned error: %v", err)
+	}
+
+	select {
+	case ev := <-events:
+		if ev.Lag <= 10*time.Millisecond || ev.Resolution != time.Hour {
+			t.Errorf("Unexpected stall event: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watchdog did not report the stall")
+	}
+
+	select {
+	case <-tc.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Stop from the watchdog callback did not complete")
+	}
+	if s := tc.Stats(); s.Stalls != 1 {
+		t.Errorf("Stalls mismatch: got %d, want 1", s.Stalls)
+	}
+}
+
+func TestWatchdogIgnoresInlineRefreshes(t *testing.T) {
+	events := make(chan StallEvent, 1)
+	tc, err := NewWithOptions(
+		WithResolution(time.Millisecond),
+		WithMaxStaleness(time.Millisecond),
+		WithWatchdog(10*time.Millisecond, func(ev StallEvent) { events <- ev }),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	// Readers keep the value fresh while the updater stops ticking
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				tc.CachedTimeNano()
+				time.Sleep(100 * time.Microsecond)
+			}
+		}
+	}()
+	if err := tc.SetResolution(time.Hour); err != nil {
+		t.Fatalf("SetResolution returned error: %v", err)
+	}
+
+	select {
+	case ev := <-events:
+		if ev.Lag <= 10*time.Millisecond {
+			t.Errorf("Unexpected stall event: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watchdog missed a stalled updater hidden by inline refreshes")
+	}
+}
+
+func TestWatchdogIgnoresIdlePause(t *testing.T) {
+	events := make(chan StallEvent, 1)
+	tc, err := NewWithOptions(
+		WithMode(ModeIdle),
+		WithResolution(100*time.Microsecond),
+		WithIdleTicks(5),
+		WithWatchdog(5*time.Millisecond, func(ev StallEvent) { events <- ev }),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if !waitIdle(tc, time.Second) {
+		t.Fatal("Updater did not pause")
+	}
+	select {
+	case ev := <-events:
+		t.Errorf("Paused updater reported as stalled: %+v", ev)
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestWatchdogAfterIdleResume(t *testing.T) {
+	events := make(chan StallEvent, 1)
+	tc, err := NewWithOptions(
+		WithMode(ModeIdle),
+		WithResolution(100*time.Microsecond),
+		WithIdleTicks(5),
+		WithWatchdog(5*time.Millisecond, func(ev StallEvent) { events <- ev }),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions returned error: %v", err)
+	}
+	defer tc.Stop()
+
+	if !waitIdle(tc, time.Second) {
+		t.Fatal("Updater did not pause")
+	}
+	if _, _, resumed := tc.stats.lastTick(); resumed == nil {
+		t.Fatal("Paused updater has no resume signal for the watchdog")
+	}
+
+	// Once woken, an updater that stops ticking is reported again
+	if err := tc.SetResolution(time.Hour); err != nil {
+		t.Fatalf("SetResolution returned error: %v", err)
+	}
+	tc.CachedTimeNano()
+	select {
+	case ev := <-events:
+		if ev.Lag <= 5*time.Millisecond {
+			t.Errorf("Unexpected stall event: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watchdog did not resume with the updater")
+	}
+}
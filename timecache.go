@@ -7,8 +7,11 @@
 package timecache
 
 import (
+	"context"
+	"sync"
 	"sync/atomic"
 	"time"
+	"unsafe"
 )
 
 // TimeCache provides cached time access to eliminate time.Now() allocations.
@@ -20,35 +23,185 @@ import (
 // zero-allocation access to the cached time value.
 //
 // The cache automatically starts updating when created and must be stopped
-// explicitly to prevent goroutine leaks.
+// explicitly to prevent goroutine leaks. A stopped cache keeps returning its
+// last value and can be resumed with Start or Restart.
 type TimeCache struct {
 	// cachedTimeNano stores the current time in nanoseconds since Unix epoch.
 	// This field is accessed atomically and provides zero-allocation time access.
 	cachedTimeNano int64
 
+	// cachedMonoNano stores the monotonic time elapsed since epoch at the last update.
+	// It is accessed atomically and is immune to wall clock steps.
+	cachedMonoNano int64
+
+	// lastUnique is the last value returned by UniqueNano. It is written by
+	// every UniqueNano call, so it is padded onto its own cache line to keep
+	// those writes from invalidating the line read by CachedTimeNano.
+	_          [cacheLineSize]byte
+	lastUnique int64
+	_          [cacheLineSize]byte
+
+	// mode selects how the cached time is kept up to date. It is fixed at construction.
+	mode Mode
+
+	// plain reports whether reads are a bare atomic load of cachedTimeNano:
+	// ModeTicker without a staleness bound. It is fixed at construction.
+	plain bool
+
+	// nonDecreasing makes every write go through advance (see WithNonDecreasing).
+	nonDecreasing bool
+
+	// maxStaleness, if positive, bounds the age of every value returned by
+	// CachedTimeNano (see WithMaxStaleness).
+	maxStaleness time.Duration
+
+	// epoch is the creation time of the cache, carrying a monotonic clock reading.
+	// Monotonic values are published as offsets from it.
+	epoch time.Time
+
+	// rfc3339 memoizes the RFC3339Nano rendering returned by CachedTimeString.
+	rfc3339 *formatCache
+
+	// httpDate memoizes the HTTPDateLayout rendering returned by HTTPDate.
+	httpDate *formatCache
+
+	// syslog5424, syslog3164 and clf memoize the log timestamp presets.
+	syslog5424 *formatCache
+	syslog3164 *formatCache
+	clf        *formatCache
+
+	// defaultOwned reports whether this package created the cache as the
+	// default, in which case it is stopped when replaced and restarted when
+	// installed again. It is guarded by defaultMu.
+	defaultOwned bool
+
+	// layouts holds the custom layouts registered with RegisterLayout, keyed by layout.
+	layouts   map[string]*formatCache
+	layoutsMu sync.Mutex
+
+	// ctx bounds the lifetime of the cache: the updater exits when it is done.
+	ctx context.Context
+
+	// mu guards the updater lifecycle: running, ticker, stopCh and doneCh.
+	mu sync.Mutex
+
+	// running reports whether the background updater goroutine is active.
+	running bool
+
 	// ticker drives the periodic updates of the cached time value.
 	ticker *time.Ticker
 
 	// stopCh is used to signal the background updater goroutine to stop.
 	stopCh chan struct{}
 
-	// resolution controls how frequently the cached time is updated.
+	// doneCh is closed by the background updater goroutine when it exits.
+	doneCh chan struct{}
+
+	// idleTicks is the number of consecutive ticks without reads after which
+	// the updater pauses in ModeIdle.
+	idleTicks int
+
+	// reads is set to 1 by readers in ModeIdle and ModeAdaptive and cleared by
+	// the updater on every tick. Readers only write it when it is 0 to avoid
+	// contention.
+	reads uint32
+
+	// idle is 1 while the updater is paused in ModeIdle.
+	idle uint32
+
+	// wakeCh carries the wake-up signal from the first read after a pause.
+	wakeCh chan struct{}
+
+	// adaptiveMin and adaptiveMax bound the resolution in ModeAdaptive.
+	adaptiveMin time.Duration
+	adaptiveMax time.Duration
+
+	// amortizedReads is the read count after which ModeAmortized refreshes
+	// the value regardless of its age.
+	amortizedReads uint64
+
+	// readCount counts reads in ModeAmortized.
+	readCount uint64
+
+	// watchdogThreshold and watchdogFn configure the stall watchdog
+	// (see WithWatchdog); watchdogFn is nil when it is disabled.
+	watchdogThreshold time.Duration
+	watchdogFn        func(StallEvent)
+
+	// jumpFn is the handler set with OnClockJump, or nil.
+	jumpFn atomic.Pointer[func(JumpEvent)]
+
+	// stats collects the updater health metrics reported by Stats.
+	stats updaterStats
+
+	// resolution controls how frequently the cached time is updated, as a
+	// time.Duration. It is accessed atomically so it can change at runtime.
 	// Smaller values provide more accurate timestamps but consume more CPU.
-	resolution time.Duration
+	resolution int64
 }
 
+// cacheLineSize is the cache line size assumed when separating fields
+// written concurrently from the read-mostly ones.
+const cacheLineSize = 64
+
 // defaultCache is the global time cache instance with default settings.
-// It is initialized automatically when the package is imported and provides
-// convenient access to cached time without requiring explicit cache management.
-var defaultCache *TimeCache
+// It is created lazily on first use and provides convenient access to cached
+// time without requiring explicit cache management. It is swapped atomically
+// by SetDefault and ConfigureDefault and is never nil: until first use it
+// points to uninitializedDefault.
+//
+// It holds a *TimeCache accessed with atomic.LoadPointer rather than an
+// atomic.Pointer[TimeCache], whose generic Load pushes the global
+// CachedTimeNano over the compiler's inlining budget.
+var defaultCache = unsafe.Pointer(uninitializedDefault)
+
+// uninitializedDefault is the placeholder default cache before first use.
+// It is not plain, so the fast paths of the global functions fall through
+// to loadDefault without an extra nil check.
+var uninitializedDefault = &TimeCache{mode: modeUninitialized}
+
+// defaultMu serializes creation and replacements of the default cache.
+var defaultMu sync.Mutex
+
+// loadDefault returns the current default cache, creating it on first use.
+// After initialization the cost is a single atomic load and nil check.
+func loadDefault() *TimeCache {
+	if tc := currentDefault(); tc != uninitializedDefault {
+		return tc
+	}
+	return initDefault()
+}
+
+// currentDefault atomically loads the default cache.
+func currentDefault() *TimeCache {
+	return (*TimeCache)(atomic.LoadPointer(&defaultCache))
+}
+
+// initDefault creates the default cache unless another goroutine won the race.
+// Importing the package therefore costs no goroutine and no ticker wakeups
+// until the default cache is actually used.
+func initDefault() *TimeCache {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	return defaultLocked()
+}
 
-func init() {
-	// Initialize the default time cache with standard settings (500µs resolution)
-	// This provides a good balance between accuracy and CPU usage for most applications.
-	defaultCache = NewWithResolution(500 * time.Microsecond)
+// defaultLocked returns the current default cache, creating it if it is
+// still uninitialized. The caller must hold defaultMu.
+func defaultLocked() *TimeCache {
+	if tc := currentDefault(); tc != uninitializedDefault {
+		return tc
+	}
+	// Default settings (500µs resolution) provide a good balance between accuracy
+	// and CPU usage for most applications; TIMECACHE_* variables may override them.
+	tc := newDefaultFromEnv()
+	tc.defaultOwned = true
+	atomic.StorePointer(&defaultCache, unsafe.Pointer(tc))
+	return tc
 }
 
-// New creates a new TimeCache with default resolution (500µs).
+// New creates a new TimeCache with default resolution (DefaultResolution, 500µs).
 //
 // The default resolution provides a good balance between accuracy and CPU usage
 // for most high-throughput applications. The cache starts updating immediately
@@ -60,7 +213,7 @@ func init() {
 //	defer tc.Stop()
 //	now := tc.CachedTime()
 func New() *TimeCache {
-	return NewWithResolution(500 * time.Microsecond)
+	return mustNew()
 }
 
 // NewWithResolution creates a new TimeCache with custom update resolution.
@@ -76,6 +229,10 @@ func New() *TimeCache {
 // The cache starts updating immediately and must be stopped explicitly
 // to prevent goroutine leaks.
 //
+// NewWithResolution accepts any positive resolution and panics otherwise;
+// use NewWithOptions to handle invalid values as errors. Unlike NewWithOptions
+// and SetResolution, it does not enforce [MinResolution, MaxResolution].
+//
 // Example:
 //
 //	// High precision cache for real-time logging
@@ -86,37 +243,169 @@ func New() *TimeCache {
 //	tc2 := timecache.NewWithResolution(1 * time.Millisecond)
 //	defer tc2.Stop()
 func NewWithResolution(resolution time.Duration) *TimeCache {
+	return mustNew(withLegacyResolution(resolution))
+}
+
+// NewWithContext creates a new TimeCache with custom update resolution whose
+// background updater is bound to ctx.
+//
+// When ctx is cancelled the updater goroutine and its ticker are released
+// automatically, so forgetting to call Stop does not leak them, and the
+// channel returned by Done is closed. The cache keeps returning its last value
+// afterwards and cannot be restarted. Stop can still be used to stop the
+// cache earlier.
+//
+// NewWithContext accepts any positive resolution, like NewWithResolution, and
+// panics if ctx is nil or resolution is not positive; use NewWithOptions to
+// handle them as errors.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	tc := timecache.NewWithContext(ctx, 1*time.Millisecond)
+//	// ... use the cache; it stops when ctx is cancelled ...
+//	<-tc.Done()
+func NewWithContext(ctx context.Context, resolution time.Duration) *TimeCache {
+	return mustNew(WithContext(ctx), withLegacyResolution(resolution))
+}
+
+// newTimeCache creates and starts a TimeCache from validated settings.
+func newTimeCache(cfg config) *TimeCache {
 	tc := &TimeCache{
-		resolution: resolution,
-		stopCh:     make(chan struct{}),
+		ctx:          cfg.ctx,
+		mode:         cfg.mode,
+		plain:        cfg.mode == ModeTicker && cfg.maxStaleness == 0,
+		maxStaleness: cfg.maxStaleness,
+		idleTicks:    cfg.idleTicks,
+		wakeCh:       make(chan struct{}, 1),
+		adaptiveMin:  cfg.adaptiveMin,
+		adaptiveMax:  cfg.adaptiveMax,
+		resolution:   int64(cfg.resolution),
+		rfc3339:      newFormatCache(time.RFC3339Nano, time.UTC),
+		httpDate:     newFormatCacheGranular(HTTPDateLayout, time.UTC, time.Second),
+		syslog5424:   newFormatCacheGranular(SyslogRFC5424Layout, time.UTC, time.Microsecond),
+		syslog3164:   newFormatCacheGranular(SyslogRFC3164Layout, time.UTC, time.Second),
+		clf:          newFormatCacheGranular(CLFLayout, time.UTC, time.Second),
+
+		amortizedReads: uint64(cfg.amortizedReads),
+
+		nonDecreasing: cfg.nonDecreasing,
+
+		watchdogThreshold: cfg.watchdogThreshold,
+		watchdogFn:        cfg.watchdogFn,
 	}
 
 	// Initialize with current time
-	tc.cachedTimeNano = time.Now().UnixNano()
-	tc.ticker = time.NewTicker(resolution)
+	tc.epoch = time.Now()
+	tc.cachedTimeNano = tc.epoch.UnixNano()
+
+	if !tc.mode.updater() || cfg.ctx.Err() != nil {
+		// No updater will ever run; report it as terminated.
+		tc.doneCh = make(chan struct{})
+		close(tc.doneCh)
+		return tc
+	}
 
 	// Start background updater
-	go tc.updateLoop()
+	tc.Start()
 
 	return tc
 }
 
 // updateLoop runs in background to update cached time.
-// This method is called automatically when a TimeCache is created
-// and runs until the cache is stopped.
-func (tc *TimeCache) updateLoop() {
+// This method is started by Start and runs until stop is closed or the
+// cache context is done, closing done on exit.
+func (tc *TimeCache) updateLoop(ticker *time.Ticker, stop chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	defer ticker.Stop()
+
+	if tc.watchdogFn != nil {
+		// The watchdog shares the lifetime of the updater, which waits for
+		// it before reporting termination.
+		watchdogDone := make(chan struct{})
+		go tc.watch(stop, watchdogDone)
+		defer func() { <-watchdogDone }()
+	}
+
+	// quiet counts consecutive ticks without reads in ModeIdle.
+	quiet := 0
+
+	// streak counts consecutive ticks with (positive) or without (negative)
+	// reads in ModeAdaptive.
+	streak := 0
+
+	// prev is the time of the previous update, used to detect clock jumps.
+	var prev time.Time
+
 	for {
 		select {
-		case <-tc.ticker.C:
-			// Update cached time atomically - zero allocation
-			atomic.StoreInt64(&tc.cachedTimeNano, time.Now().UnixNano())
-		case <-tc.stopCh:
-			tc.ticker.Stop()
+		case <-ticker.C:
+			// A tick and a stop may be ready together; never update after Stop.
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			now := time.Now()
+			tc.store(now)
+			tc.stats.tick(now, tc.Resolution())
+			if !prev.IsZero() {
+				tc.checkJump(prev, now)
+			}
+			prev = now
+
+			switch tc.mode {
+			case ModeIdle:
+				if tc.idleTick(&quiet) && !tc.pause(ticker, stop) {
+					return
+				}
+			case ModeAdaptive:
+				tc.adaptTick(ticker, &streak)
+			}
+		case <-stop:
+			return
+		case <-tc.ctx.Done():
+			tc.contextDone(stop)
 			return
 		}
 	}
 }
 
+// contextDone marks the updater identified by stop as no longer running
+// after the cache context is done.
+func (tc *TimeCache) contextDone(stop chan struct{}) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if tc.stopCh == stop {
+		tc.running = false
+	}
+}
+
+// store publishes now as the cached wall and monotonic time and returns
+// the published wall time. Both values are updated atomically - zero allocation.
+func (tc *TimeCache) store(now time.Time) int64 {
+	if tc.nonDecreasing {
+		return tc.advance(now)
+	}
+	atomic.StoreInt64(&tc.cachedTimeNano, now.UnixNano())
+	atomic.StoreInt64(&tc.cachedMonoNano, int64(now.Sub(tc.epoch)))
+	return now.UnixNano()
+}
+
+// publish stores now as the cached time on behalf of a reader that observed
+// nanos and returns the time the reader should use. Only a reader that still
+// observes nanos publishes, so a slow refresher never overwrites a newer value.
+func (tc *TimeCache) publish(nanos int64, now time.Time) int64 {
+	if tc.nonDecreasing {
+		return tc.advance(now)
+	}
+	if atomic.CompareAndSwapInt64(&tc.cachedTimeNano, nanos, now.UnixNano()) {
+		atomic.StoreInt64(&tc.cachedMonoNano, int64(now.Sub(tc.epoch)))
+	}
+	return now.UnixNano()
+}
+
 // CachedTimeNano returns the cached time in nanoseconds since Unix epoch.
 // This method provides zero-allocation access to the current timestamp
 // and is the fastest way to get time information from the cache.
@@ -130,6 +419,33 @@ func (tc *TimeCache) updateLoop() {
 //	nano := tc.CachedTimeNano()
 //	fmt.Printf("Timestamp: %d nanoseconds\n", nano)
 func (tc *TimeCache) CachedTimeNano() int64 {
+	if tc.plain {
+		return atomic.LoadInt64(&tc.cachedTimeNano)
+	}
+	return tc.readNano(tc.maxStaleness, tc.maxStaleness > 0)
+}
+
+// readNano is the read path of caches whose reads are not a bare atomic load.
+// It applies the side effects of the mode and, if bounded, refreshes values
+// older than maxAge. It is kept out of CachedTimeNano so the plain fast path
+// stays inlinable.
+func (tc *TimeCache) readNano(maxAge time.Duration, bounded bool) int64 {
+	switch tc.mode {
+	case ModeDirect:
+		if tc.nonDecreasing {
+			return tc.advance(time.Now())
+		}
+		return time.Now().UnixNano()
+	case ModeIdle:
+		tc.readIdle()
+	case ModeAdaptive:
+		tc.markRead()
+	case ModeAmortized:
+		tc.readAmortized()
+	}
+	if bounded {
+		return tc.boundedNano(maxAge)
+	}
 	return atomic.LoadInt64(&tc.cachedTimeNano)
 }
 
@@ -144,8 +460,7 @@ func (tc *TimeCache) CachedTimeNano() int64 {
 //	now := tc.CachedTime()
 //	fmt.Printf("Current time: %v\n", now)
 func (tc *TimeCache) CachedTime() time.Time {
-	nanos := atomic.LoadInt64(&tc.cachedTimeNano)
-	return time.Unix(0, nanos)
+	return time.Unix(0, tc.CachedTimeNano())
 }
 
 // CachedTimeString returns the cached time formatted as an RFC3339Nano string.
@@ -155,6 +470,9 @@ func (tc *TimeCache) CachedTime() time.Time {
 // This method is useful for logging, API responses, or any scenario
 // where a standardized time string format is required.
 //
+// The string is formatted at most once per cache update and shared by all
+// callers until the next update, so repeated reads are zero-allocation.
+//
 // Example:
 //
 //	tc := timecache.New()
@@ -162,13 +480,73 @@ func (tc *TimeCache) CachedTime() time.Time {
 //	timeStr := tc.CachedTimeString()
 //	fmt.Printf("ISO timestamp: %s\n", timeStr)
 func (tc *TimeCache) CachedTimeString() string {
-	nanos := atomic.LoadInt64(&tc.cachedTimeNano)
-	return time.Unix(0, nanos).UTC().Format(time.RFC3339Nano)
+	return tc.rfc3339.get(tc.CachedTimeNano()).s
+}
+
+// CachedMonotonic returns the cached time as a time.Time value carrying a
+// monotonic clock reading.
+//
+// Unlike CachedTime, the result is safe for elapsed-time arithmetic: Sub
+// between two CachedMonotonic values, or between a CachedMonotonic value and
+// time.Now(), uses the monotonic clock and is unaffected by wall clock steps
+// (NTP corrections, manual changes). Its wall clock component is derived from
+// the cache creation time and may drift from CachedTime after a clock step,
+// so use CachedTime for timestamps and CachedMonotonic for measuring.
+//
+// Example:
+//
+//	tc := timecache.New()
+//	defer tc.Stop()
+//	start := tc.CachedMonotonic()
+//	// ... do work ...
+//	elapsed := tc.Since(start)
+func (tc *TimeCache) CachedMonotonic() time.Time {
+	if tc.mode == ModeDirect {
+		return tc.epoch.Add(time.Since(tc.epoch))
+	}
+	if !tc.plain {
+		// Apply mode side effects and the staleness bound, which may refresh the value.
+		tc.readNano(tc.maxStaleness, tc.maxStaleness > 0)
+	}
+	return tc.epoch.Add(time.Duration(atomic.LoadInt64(&tc.cachedMonoNano)))
+}
+
+// Since returns the time elapsed since t, measured against the cached
+// monotonic time. It is the cached equivalent of time.Since.
+//
+// If t carries a monotonic clock reading (values from CachedMonotonic or
+// time.Now), the result is immune to wall clock steps. Values without a
+// monotonic reading, such as those from CachedTime, fall back to wall clock
+// arithmetic.
+//
+// Example:
+//
+//	start := tc.CachedMonotonic()
+//	handle(request)
+//	latency := tc.Since(start)
+func (tc *TimeCache) Since(t time.Time) time.Duration {
+	return tc.CachedMonotonic().Sub(t)
+}
+
+// Until returns the duration until t, measured against the cached
+// monotonic time. It is the cached equivalent of time.Until.
+//
+// The same monotonic rules as Since apply.
+//
+// Example:
+//
+//	deadline := tc.CachedMonotonic().Add(5 * time.Second)
+//	if tc.Until(deadline) <= 0 {
+//		return ErrTimeout
+//	}
+func (tc *TimeCache) Until(t time.Time) time.Duration {
+	return t.Sub(tc.CachedMonotonic())
 }
 
 // Resolution returns the update frequency of this cache.
 // This is the interval at which the cached time value is refreshed
-// by the background updater goroutine.
+// by the background updater goroutine. In ModeAdaptive it is the
+// current effective value, which changes with the read rate.
 //
 // Example:
 //
@@ -176,15 +554,109 @@ func (tc *TimeCache) CachedTimeString() string {
 //	defer tc.Stop()
 //	fmt.Printf("Cache updates every: %v\n", tc.Resolution())
 func (tc *TimeCache) Resolution() time.Duration {
-	return tc.resolution
+	return time.Duration(atomic.LoadInt64(&tc.resolution))
+}
+
+// SetResolution changes the update frequency of a live cache.
+//
+// The new resolution must be within [MinResolution, MaxResolution]; otherwise
+// an error wrapping ErrInvalidResolution is returned and the cache is left
+// unchanged. A running updater switches to the new interval immediately,
+// and a stopped cache uses it on the next Start. Concurrent readers are not
+// affected and keep reading lock-free. In ModeAdaptive the new value is
+// the starting point from which the resolution keeps adapting.
+//
+// Example:
+//
+//	// Raise precision while debugging an incident
+//	if err := tc.SetResolution(100 * time.Microsecond); err != nil {
+//		return err
+//	}
+func (tc *TimeCache) SetResolution(resolution time.Duration) error {
+	if err := validateResolution(resolution); err != nil {
+		return err
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	atomic.StoreInt64(&tc.resolution, int64(resolution))
+	if tc.running {
+		tc.ticker.Reset(resolution)
+	}
+	return nil
+}
+
+// Start starts the background updater if it is not running.
+// New caches are started automatically, so Start is only needed to resume
+// a cache after Stop. The cached value is refreshed immediately so readers
+// never observe the time at which the cache was stopped.
+//
+// Start is safe to call concurrently and is a no-op on a running cache, on
+// a cache whose context (see NewWithContext) is done, and in ModeDirect and
+// ModeAmortized, which have no updater.
+//
+// Example:
+//
+//	tc.Stop()
+//	// ... maintenance window ...
+//	tc.Start()
+func (tc *TimeCache) Start() {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.running || tc.ctx.Err() != nil || !tc.mode.updater() {
+		return
+	}
+	now := time.Now()
+	tc.store(now)
+	tc.stats.resume(now)
+	atomic.StoreUint32(&tc.idle, 0)
+	tc.ticker = time.NewTicker(tc.Resolution())
+	tc.stopCh = make(chan struct{})
+	tc.doneCh = make(chan struct{})
+	tc.running = true
+
+	go tc.updateLoop(tc.ticker, tc.stopCh, tc.doneCh)
+}
+
+// Restart stops the background updater, waits for it to exit and starts
+// it again. It is equivalent to Stop followed by Start, and starts a
+// stopped cache.
+//
+// Example:
+//
+//	tc.Restart()
+func (tc *TimeCache) Restart() {
+	tc.Stop()
+	tc.Start()
 }
 
-// Stop permanently stops the time cache updater.
-// After calling Stop, the cached time value will no longer be updated
-// and the background goroutine will terminate.
+// Done returns a channel that is closed when the background updater started
+// by the most recent Start (or by the constructor) has terminated, whether
+// because of Stop or because the cache context was cancelled.
+// After a restart, Done returns a new channel for the new updater.
+//
+// Example:
+//
+//	tc := timecache.NewWithContext(ctx, time.Millisecond)
+//	go func() {
+//		<-tc.Done()
+//		log.Println("timecache stopped")
+//	}()
+func (tc *TimeCache) Done() <-chan struct{} {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	return tc.doneCh
+}
+
+// Stop stops the time cache updater.
+// After Stop returns, the background goroutine has terminated and the cached
+// time value will no longer be updated until Start or Restart is called.
 //
 // It is important to call Stop to prevent goroutine leaks when
-// the cache is no longer needed.
+// the cache is no longer needed. Stop is safe to call concurrently
+// and repeatedly; calls on a stopped cache are no-ops.
 //
 // Example:
 //
@@ -192,7 +664,41 @@ func (tc *TimeCache) Resolution() time.Duration {
 //	// ... use the cache ...
 //	tc.Stop() // Clean up resources
 func (tc *TimeCache) Stop() {
-	close(tc.stopCh)
+	_ = tc.StopAndWait(context.Background())
+}
+
+// StopAndWait stops the time cache updater and blocks until the background
+// goroutine has terminated or ctx is done, whichever happens first.
+// It returns ctx.Err() if ctx is done before the goroutine exits; the
+// goroutine has still been signalled and will exit on its own.
+//
+// Like Stop, it is safe to call concurrently and repeatedly.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	if err := tc.StopAndWait(ctx); err != nil {
+//		log.Printf("timecache did not stop in time: %v", err)
+//	}
+func (tc *TimeCache) StopAndWait(ctx context.Context) error {
+	tc.mu.Lock()
+	done := tc.doneCh
+	if tc.running {
+		close(tc.stopCh)
+		tc.running = false
+	}
+	tc.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Global API functions using the default time cache instance.
@@ -210,7 +716,18 @@ func (tc *TimeCache) Stop() {
 //	nano := timecache.CachedTimeNano()
 //	fmt.Printf("Timestamp: %d nanoseconds\n", nano)
 func CachedTimeNano() int64 {
-	return defaultCache.CachedTimeNano()
+	// Hand-inlined fast path for an initialized plain default cache,
+	// keeping this function within the compiler's inlining budget.
+	if tc := currentDefault(); tc.plain {
+		return atomic.LoadInt64(&tc.cachedTimeNano)
+	}
+	return cachedTimeNanoSlow()
+}
+
+// cachedTimeNanoSlow is the CachedTimeNano path for an uninitialized default
+// cache or a default cache whose reads are not a bare atomic load.
+func cachedTimeNanoSlow() int64 {
+	return loadDefault().CachedTimeNano()
 }
 
 // CachedTime returns the cached time as a time.Time value from the default cache.
@@ -222,7 +739,7 @@ func CachedTimeNano() int64 {
 //	now := timecache.CachedTime()
 //	fmt.Printf("Current time: %v\n", now)
 func CachedTime() time.Time {
-	return defaultCache.CachedTime()
+	return time.Unix(0, CachedTimeNano())
 }
 
 // CachedTimeString returns the cached time formatted as an RFC3339Nano string from the default cache.
@@ -237,24 +754,159 @@ func CachedTime() time.Time {
 //	timeStr := timecache.CachedTimeString()
 //	fmt.Printf("ISO timestamp: %s\n", timeStr)
 func CachedTimeString() string {
-	return defaultCache.CachedTimeString()
+	return loadDefault().CachedTimeString()
+}
+
+// CachedMonotonic returns the cached time carrying a monotonic clock reading
+// from the default cache. See (*TimeCache).CachedMonotonic for details.
+//
+// Example:
+//
+//	start := timecache.CachedMonotonic()
+//	// ... do work ...
+//	fmt.Printf("Elapsed: %v\n", timecache.Since(start))
+func CachedMonotonic() time.Time {
+	return loadDefault().CachedMonotonic()
+}
+
+// Since returns the time elapsed since t, measured against the monotonic
+// time of the default cache. See (*TimeCache).Since for details.
+//
+// Example:
+//
+//	start := timecache.CachedMonotonic()
+//	process()
+//	fmt.Printf("Took: %v\n", timecache.Since(start))
+func Since(t time.Time) time.Duration {
+	return loadDefault().Since(t)
+}
+
+// Until returns the duration until t, measured against the monotonic
+// time of the default cache. See (*TimeCache).Until for details.
+//
+// Example:
+//
+//	deadline := timecache.CachedMonotonic().Add(time.Second)
+//	remaining := timecache.Until(deadline)
+func Until(t time.Time) time.Duration {
+	return loadDefault().Until(t)
 }
 
 // DefaultCache returns the global default TimeCache instance.
 // This allows access to the default cache for advanced operations
 // like checking resolution or stopping the cache.
 //
+// The returned instance is the default at the time of the call; it may
+// be replaced later by SetDefault or ConfigureDefault, which stop it if this
+// package created it. Views and layout handles obtained from it, such as
+// DefaultCache().RegisterLayout, stay bound to it and freeze once it is
+// stopped; package-level functions and views from the package-level In
+// always follow the current default.
+//
 // Example:
 //
 //	defaultCache := timecache.DefaultCache()
 //	fmt.Printf("Default cache resolution: %v\n", defaultCache.Resolution())
 func DefaultCache() *TimeCache {
-	return defaultCache
+	return loadDefault()
+}
+
+// SetDefault makes tc the global default cache used by the package-level
+// functions and returns the previous default.
+//
+// The swap is atomic: concurrent callers of the global functions observe
+// either the previous or the new cache, never nil. The caller keeps ownership
+// of tc. A previous default created by this package is stopped automatically
+// and returned for inspection only; one installed by an earlier SetDefault is
+// left running and must be stopped by its owner. Installing a cache created
+// by this package again restarts it.
+//
+// If the default cache has not been used yet, none is created and previous is
+// nil. Passing nil restores that state: the next use creates a fresh default.
+// Saving and restoring the default therefore works in every case:
+//
+//	prev := timecache.SetDefault(tc)
+//	defer timecache.SetDefault(prev)
+//
+// Views and layout handles obtained from the previous default through
+// DefaultCache keep reading it, and freeze if it was stopped; obtain them
+// again from the new default. Views from the package-level In follow the
+// swap.
+//
+// Example:
+//
+//	tc := timecache.NewWithResolution(100 * time.Microsecond)
+//	timecache.SetDefault(tc)
+func SetDefault(tc *TimeCache) (previous *TimeCache) {
+	if tc == nil {
+		tc = uninitializedDefault
+	}
+	return swapDefault(tc, false)
+}
+
+// ConfigureDefault replaces the global default cache with a new cache
+// created from opts. On error the current default is left untouched.
+//
+// The new cache is owned by the package: it is stopped automatically when it
+// is replaced again. The swap is atomic, as with SetDefault, and the same
+// caveat applies to views and layout handles obtained through DefaultCache.
+//
+// Example:
+//
+//	if err := timecache.ConfigureDefault(timecache.WithResolution(time.Millisecond)); err != nil {
+//		log.Fatal(err)
+//	}
+func ConfigureDefault(opts ...Option) error {
+	tc, err := NewWithOptions(opts...)
+	if err != nil {
+		return err
+	}
+	swapDefault(tc, true)
+	return nil
+}
+
+// swapDefault installs tc as the default cache, stopping the previous one
+// if this package created it. Owned marks tc as created by this package.
+func swapDefault(tc *TimeCache, owned bool) *TimeCache {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if owned {
+		tc.defaultOwned = true
+	}
+	if tc.defaultOwned {
+		// A package-owned cache reinstalled by SetDefault was stopped when
+		// it was replaced.
+		tc.Start()
+	}
+	previous := (*TimeCache)(atomic.SwapPointer(&defaultCache, unsafe.Pointer(tc)))
+	if previous == uninitializedDefault {
+		return nil
+	}
+	if previous.defaultOwned && previous != tc {
+		previous.Stop()
+	}
+	return previous
+}
+
+// StartDefaultCache restarts the global default time cache after
+// StopDefaultCache. It is a no-op if the default cache is running.
+//
+// Example:
+//
+//	timecache.StopDefaultCache()
+//	// ... later ...
+//	timecache.StartDefaultCache()
+func StartDefaultCache() {
+	loadDefault().Start()
 }
 
 // StopDefaultCache stops the global default time cache.
 // After calling this function, the default cache will no longer be updated
-// and the background goroutine will terminate.
+// and the background goroutine will terminate. The global functions keep
+// returning the last cached value until StartDefaultCache is called or the
+// default is replaced with SetDefault or ConfigureDefault. If the default
+// cache has not been used yet, it is created stopped.
 //
 // This function is mainly intended for testing and shutdown scenarios.
 // In normal application usage, the default cache should remain running.
@@ -264,5 +916,7 @@ func DefaultCache() *TimeCache {
 //	// During application shutdown
 //	timecache.StopDefaultCache()
 func StopDefaultCache() {
-	defaultCache.Stop()
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLocked().Stop()
 }
@@ -7,6 +7,9 @@
 package timecache
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -232,5 +235,462 @@ func TestStopDefaultCache(t *testing.T) {
 	}
 
 	// After tests, reinitialize default cache for other tests
-	DefaultCache()
+	StartDefaultCache()
+}
+
+func TestCachedMonotonic(t *testing.T) {
+	tc := NewWithResolution(100 * time.Microsecond)
+	defer tc.Stop()
+
+	start := tc.CachedMonotonic()
+
+	// The returned value must carry a monotonic clock reading
+	if !strings.Contains(start.String(), "m=") {
+		t.Errorf("CachedMonotonic has no monotonic reading: %s", start.String())
+	}
+
+	// Stripping the monotonic reading must not change the instant by more than the resolution
+	diff := start.Sub(tc.CachedTime())
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > 5*time.Millisecond {
+		t.Errorf("CachedMonotonic too far from CachedTime: diff=%v", diff)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	elapsed := tc.Since(start)
+	if elapsed < time.Millisecond || elapsed > time.Second {
+		t.Errorf("Since returned unexpected duration: %v", elapsed)
+	}
+
+	deadline := start.Add(time.Hour)
+	remaining := tc.Until(deadline)
+	if remaining <= 0 || remaining > time.Hour-time.Millisecond {
+		t.Errorf("Until returned unexpected duration: %v", remaining)
+	}
+}
+
+func TestGlobalMonotonic(t *testing.T) {
+	start := CachedMonotonic()
+	if Since(start) < 0 {
+		t.Errorf("Since went negative: %v", Since(start))
+	}
+	if Until(start.Add(time.Minute)) <= 0 {
+		t.Errorf("Until returned non-positive duration: %v", Until(start.Add(time.Minute)))
+	}
+}
+
+func TestStopIdempotent(t *testing.T) {
+	tc := New()
+
+	// Concurrent and repeated Stop calls must not panic
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		go func() {
+			tc.Stop()
+			done <- struct{}{}
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+	tc.Stop()
+
+	if err := tc.StopAndWait(context.Background()); err != nil {
+		t.Errorf("StopAndWait on stopped cache returned error: %v", err)
+	}
+}
+
+func TestStopAndWait(t *testing.T) {
+	tc := New()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := tc.StopAndWait(ctx); err != nil {
+		t.Fatalf("StopAndWait returned error: %v", err)
+	}
+
+	// The updater has exited, so the value must be frozen immediately
+	initial := tc.CachedTimeNano()
+	time.Sleep(2 * time.Millisecond)
+	if after := tc.CachedTimeNano(); after != initial {
+		t.Errorf("Time changed after StopAndWait: initial=%d, after=%d", initial, after)
+	}
+}
+
+func TestStartRestart(t *testing.T) {
+	tc := NewWithResolution(100 * time.Microsecond)
+	defer tc.Stop()
+
+	tc.Stop()
+	stopped := tc.CachedTimeNano()
+	time.Sleep(2 * time.Millisecond)
+
+	// Start refreshes immediately and resumes updates
+	tc.Start()
+	tc.Start() // no-op on a running cache
+	resumed := tc.CachedTimeNano()
+	if resumed <= stopped {
+		t.Errorf("Start did not refresh the cached time: stopped=%d, resumed=%d", stopped, resumed)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	if progressed := tc.CachedTimeNano(); progressed <= resumed {
+		t.Errorf("Cache did not progress after Start: resumed=%d, progressed=%d", resumed, progressed)
+	}
+
+	// Restart works on running and stopped caches alike
+	tc.Restart()
+	tc.Stop()
+	tc.Restart()
+	before := tc.CachedTimeNano()
+	time.Sleep(2 * time.Millisecond)
+	if after := tc.CachedTimeNano(); after <= before {
+		t.Errorf("Cache did not progress after Restart: before=%d, after=%d", before, after)
+	}
+}
+
+func TestNewWithContextAlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tc := NewWithContext(ctx, time.Millisecond)
+	defer tc.Stop()
+
+	// Done must be closed, not nil, and stay closed across a restart attempt
+	for _, step := range []string{"construction", "Restart"} {
+		select {
+		case <-tc.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("Done not closed after %s with a cancelled context", step)
+		}
+		tc.Restart()
+	}
+	if tc.CachedTimeNano() == 0 {
+		t.Error("Cache with a cancelled context returned zero timestamp")
+	}
+}
+
+func TestNewWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tc := NewWithContext(ctx, 100*time.Microsecond)
+	defer tc.Stop()
+
+	if tc.Resolution() != 100*time.Microsecond {
+		t.Errorf("Resolution not set correctly: got %v", tc.Resolution())
+	}
+
+	done := tc.Done()
+	select {
+	case <-done:
+		t.Fatal("Done closed before the context was cancelled")
+	default:
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Updater did not exit after context cancellation")
+	}
+
+	// The cache is frozen and cannot be restarted once its context is done
+	tc.Start()
+	initial := tc.CachedTimeNano()
+	time.Sleep(2 * time.Millisecond)
+	if after := tc.CachedTimeNano(); after != initial {
+		t.Errorf("Time changed after context cancellation: initial=%d, after=%d", initial, after)
+	}
+}
+
+func TestDoneAfterStop(t *testing.T) {
+	tc := New()
+	done := tc.Done()
+	tc.Stop()
+
+	select {
+	case <-done:
+	default:
+		t.Error("Done not closed after Stop returned")
+	}
+
+	// A restart publishes a fresh Done channel
+	tc.Start()
+	defer tc.Stop()
+	if tc.Done() == done {
+		t.Error("Done returned the previous channel after Start")
+	}
+}
+
+func TestSetResolution(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	// With an hourly ticker the cache must not progress on its own
+	before := tc.CachedTimeNano()
+	time.Sleep(2 * time.Millisecond)
+	if after := tc.CachedTimeNano(); after != before {
+		t.Fatalf("Hourly cache progressed unexpectedly: before=%d, after=%d", before, after)
+	}
+
+	if err := tc.SetResolution(100 * time.Microsecond); err != nil {
+		t.Fatalf("SetResolution returned error: %v", err)
+	}
+	if tc.Resolution() != 100*time.Microsecond {
+		t.Errorf("Resolution not updated: got %v", tc.Resolution())
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	if after := tc.CachedTimeNano(); after <= before {
+		t.Errorf("Cache did not progress after SetResolution: before=%d, after=%d", before, after)
+	}
+
+	// Invalid values are rejected and leave the cache unchanged
+	if err := tc.SetResolution(0); !errors.Is(err, ErrInvalidResolution) {
+		t.Errorf("SetResolution(0) error mismatch: got %v", err)
+	}
+	if tc.Resolution() != 100*time.Microsecond {
+		t.Errorf("Invalid SetResolution changed resolution to %v", tc.Resolution())
+	}
+
+	// A stopped cache picks the new resolution up on Start
+	tc.Stop()
+	if err := tc.SetResolution(time.Millisecond); err != nil {
+		t.Fatalf("SetResolution on stopped cache returned error: %v", err)
+	}
+	tc.Start()
+	if tc.Resolution() != time.Millisecond {
+		t.Errorf("Resolution mismatch after Start: got %v", tc.Resolution())
+	}
+}
+
+func TestSetResolutionConcurrentReads(t *testing.T) {
+	tc := New()
+	defer tc.Stop()
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = tc.CachedTimeNano()
+				_ = tc.Resolution()
+			}
+		}
+	}()
+
+	for i := 0; i < 50; i++ {
+		d := time.Duration(i%5+1) * 100 * time.Microsecond
+		if err := tc.SetResolution(d); err != nil {
+			t.Fatalf("SetResolution(%v) returned error: %v", d, err)
+		}
+	}
+	close(stop)
+	<-done
+}
+
+func TestSetDefault(t *testing.T) {
+	custom := NewWithResolution(time.Millisecond)
+	defer custom.Stop()
+
+	original := SetDefault(custom)
+	defer SetDefault(original)
+	if DefaultCache() != custom {
+		t.Error("SetDefault did not install the custom cache")
+	}
+	if CachedTimeNano() == 0 {
+		t.Error("Global functions returned zero timestamp after SetDefault")
+	}
+
+	// Replacing a caller-owned cache must not stop it
+	if err := ConfigureDefault(); err != nil {
+		t.Fatalf("ConfigureDefault returned error: %v", err)
+	}
+	select {
+	case <-custom.Done():
+		t.Error("Replacing a caller-owned default stopped it")
+	default:
+	}
+
+	// Replacing a package-owned cache stops it
+	configured := DefaultCache()
+	if previous := SetDefault(custom); previous != configured {
+		t.Error("SetDefault did not return the configured cache")
+	}
+	select {
+	case <-configured.Done():
+	case <-time.After(time.Second):
+		t.Error("Replacing a package-owned default did not stop it")
+	}
+
+	// Installing it again restarts it and keeps it package-owned
+	SetDefault(configured)
+	select {
+	case <-configured.Done():
+		t.Error("Reinstalled package-owned default was not restarted")
+	default:
+	}
+	SetDefault(custom)
+	select {
+	case <-configured.Done():
+	case <-time.After(time.Second):
+		t.Error("Reinstalled package-owned default is no longer owned by the package")
+	}
+}
+
+func TestSetDefaultNil(t *testing.T) {
+	original := SetDefault(nil)
+	defer SetDefault(original)
+	if original != nil {
+		select {
+		case <-original.Done():
+		case <-time.After(time.Second):
+			t.Error("SetDefault(nil) did not stop the package-owned default")
+		}
+	}
+	if currentDefault() != uninitializedDefault {
+		t.Fatal("SetDefault(nil) did not restore the lazy default")
+	}
+
+	// The next use creates a fresh, running, package-owned default
+	created := DefaultCache()
+	if created == uninitializedDefault || created == original {
+		t.Fatal("Default cache not recreated after SetDefault(nil)")
+	}
+	if CachedTimeNano() == 0 {
+		t.Error("Recreated default cache returned zero timestamp")
+	}
+	if previous := SetDefault(nil); previous != created {
+		t.Error("SetDefault(nil) did not return the recreated default")
+	}
+	select {
+	case <-created.Done():
+	case <-time.After(time.Second):
+		t.Error("Recreated default is not owned by the package")
+	}
+
+	// Restoring the lazy default when it is already lazy returns nil
+	if previous := SetDefault(nil); previous != nil {
+		t.Errorf("SetDefault(nil) on an unused default returned %p, want nil", previous)
+	}
+}
+
+func TestConfigureDefault(t *testing.T) {
+	original := DefaultCache()
+	defer SetDefault(original)
+
+	if err := ConfigureDefault(WithResolution(0)); !errors.Is(err, ErrInvalidResolution) {
+		t.Errorf("ConfigureDefault error mismatch: got %v", err)
+	}
+	if DefaultCache() != original {
+		t.Error("Failed ConfigureDefault replaced the default cache")
+	}
+
+	if err := ConfigureDefault(WithResolution(2 * time.Millisecond)); err != nil {
+		t.Fatalf("ConfigureDefault returned error: %v", err)
+	}
+	if got := DefaultCache().Resolution(); got != 2*time.Millisecond {
+		t.Errorf("ConfigureDefault resolution mismatch: got %v", got)
+	}
+}
+
+func TestDefaultCacheConcurrentSwap(t *testing.T) {
+	original := DefaultCache()
+	defer SetDefault(original)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				// Must never observe a nil default cache
+				_ = CachedTimeNano()
+				_ = CachedTimeString()
+			}
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		if err := ConfigureDefault(WithResolution(time.Millisecond)); err != nil {
+			t.Fatalf("ConfigureDefault returned error: %v", err)
+		}
+	}
+	close(stop)
+	<-done
+}
+
+func TestStartDefaultCache(t *testing.T) {
+	StopDefaultCache()
+	stopped := CachedTimeNano()
+
+	StartDefaultCache()
+	time.Sleep(2 * time.Millisecond)
+	if resumed := CachedTimeNano(); resumed <= stopped {
+		t.Errorf("Default cache did not resume: stopped=%d, resumed=%d", stopped, resumed)
+	}
+}
+
+func TestStopDefaultCacheBeforeFirstUse(t *testing.T) {
+	original := SetDefault(nil)
+	defer SetDefault(original)
+
+	// Stopping an unused default must keep later reads from starting it
+	StopDefaultCache()
+	first := CachedTimeNano()
+	if first == 0 {
+		t.Error("Stopped default cache returned zero timestamp")
+	}
+	select {
+	case <-DefaultCache().Done():
+	case <-time.After(time.Second):
+		t.Fatal("Default cache running after StopDefaultCache before first use")
+	}
+	time.Sleep(2 * time.Millisecond)
+	if got := CachedTimeNano(); got != first {
+		t.Errorf("Stopped default cache advanced: first=%d, got=%d", first, got)
+	}
+}
+
+func TestLazyDefaultCache(t *testing.T) {
+	// Simulate a process that has not used the default cache yet
+	original := SetDefault(nil)
+	defer SetDefault(original)
+
+	// The first read creates and starts it
+	if CachedTimeNano() == 0 {
+		t.Error("Lazily created default cache returned zero timestamp")
+	}
+	created := currentDefault()
+	if created == uninitializedDefault {
+		t.Fatal("Default cache not created on first use")
+	}
+	if DefaultCache() != created {
+		t.Error("DefaultCache returned a different instance than the lazily created one")
+	}
+
+	// Concurrent first use must agree on a single instance
+	SetDefault(nil)
+
+	results := make(chan *TimeCache, 8)
+	for i := 0; i < cap(results); i++ {
+		go func() { results <- DefaultCache() }()
+	}
+	first := <-results
+	for i := 1; i < cap(results); i++ {
+		if tc := <-results; tc != first {
+			t.Error("Concurrent first use created more than one default cache")
+		}
+	}
 }
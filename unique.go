@@ -0,0 +1,64 @@
+// unique.go: Strictly increasing unique timestamps
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+)
+
+// UniqueNano returns a timestamp in nanoseconds since Unix epoch that is
+// strictly greater than every value UniqueNano has returned before on this
+// cache, across all goroutines. It is the cached time when that advanced
+// since the last call, otherwise the last value plus one nanosecond, which
+// makes it a cheap ordering key that never calls time.Now().
+//
+// The result stays close to the cached time as long as fewer than one call
+// per nanosecond is made on average; it never goes backwards, even if the
+// wall clock does. It is lock-free and zero-allocation.
+//
+// Example:
+//
+//	key := tc.UniqueNano()
+//	store.Put(key, event)
+func (tc *TimeCache) UniqueNano() int64 {
+	return nextUnique(&tc.lastUnique, tc.CachedTimeNano())
+}
+
+// defaultUnique is the last value returned by the package-level UniqueNano.
+// It is kept apart from the default cache so the sequence survives
+// SetDefault and ConfigureDefault.
+var defaultUnique int64
+
+// UniqueNano returns a strictly increasing unique timestamp from the default cache.
+// See (*TimeCache).UniqueNano for details.
+//
+// The sequence belongs to the package, not to the default cache, so values
+// keep increasing when the default is replaced with SetDefault or
+// ConfigureDefault. It is independent from the sequences of individual caches,
+// including the one returned by DefaultCache.
+//
+// Example:
+//
+//	key := timecache.UniqueNano()
+func UniqueNano() int64 {
+	return nextUnique(&defaultUnique, CachedTimeNano())
+}
+
+// nextUnique advances the sequence in last to now, or by one nanosecond if
+// now does not exceed it, and returns the new value.
+func nextUnique(last *int64, now int64) int64 {
+	for {
+		prev := atomic.LoadInt64(last)
+		next := now
+		if next <= prev {
+			next = prev + 1
+		}
+		if atomic.CompareAndSwapInt64(last, prev, next) {
+			return next
+		}
+	}
+}
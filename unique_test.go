@@ -0,0 +1,103 @@
+// unique_test.go: Test suite for strictly increasing unique timestamps
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestUniqueNano(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	// Within one tick values advance by one nanosecond
+	cached := tc.CachedTimeNano()
+	first := tc.UniqueNano()
+	second := tc.UniqueNano()
+	if first != cached || second != first+1 {
+		t.Errorf("UniqueNano mismatch: cached=%d, first=%d, second=%d", cached, first, second)
+	}
+
+	// An advanced cache is returned as is
+	next := cached + int64(time.Millisecond)
+	atomic.StoreInt64(&tc.cachedTimeNano, next)
+	if got := tc.UniqueNano(); got != next {
+		t.Errorf("UniqueNano after advance: got %d, want %d", got, next)
+	}
+
+	// A regressing cache does not make values go backwards
+	atomic.StoreInt64(&tc.cachedTimeNano, cached)
+	if got := tc.UniqueNano(); got != next+1 {
+		t.Errorf("UniqueNano after regression: got %d, want %d", got, next+1)
+	}
+
+	if a, b := UniqueNano(), UniqueNano(); b <= a {
+		t.Errorf("Global UniqueNano not increasing: %d, %d", a, b)
+	}
+}
+
+func TestUniqueNanoOwnCacheLine(t *testing.T) {
+	var tc TimeCache
+	unique := unsafe.Offsetof(tc.lastUnique)
+	// A full line between the fields keeps them apart at any base alignment
+	if gap := unique - (unsafe.Offsetof(tc.cachedMonoNano) + 8); gap < cacheLineSize {
+		t.Errorf("lastUnique is %d bytes after cachedMonoNano, want at least %d", gap, cacheLineSize)
+	}
+	if next := unique + 8 + cacheLineSize; next > unsafe.Sizeof(tc) {
+		t.Errorf("lastUnique is not followed by a full cache line of padding")
+	}
+}
+
+func TestUniqueNanoConcurrent(t *testing.T) {
+	tc := NewWithResolution(time.Millisecond)
+	defer tc.Stop()
+
+	const goroutines, perGoroutine = 8, 5000
+	results := make(chan []int64, goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			values := make([]int64, perGoroutine)
+			for j := range values {
+				values[j] = tc.UniqueNano()
+			}
+			results <- values
+		}()
+	}
+
+	seen := make(map[int64]bool, goroutines*perGoroutine)
+	for i := 0; i < goroutines; i++ {
+		values := <-results
+		for j, v := range values {
+			if j > 0 && v <= values[j-1] {
+				t.Fatalf("UniqueNano not increasing within a goroutine: %d after %d", v, values[j-1])
+			}
+			if seen[v] {
+				t.Fatalf("UniqueNano returned duplicate value %d", v)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestUniqueNanoAcrossDefaultSwap(t *testing.T) {
+	defer ConfigureDefault()
+
+	before := UniqueNano()
+
+	// A new default whose cached time is behind must not restart the sequence
+	behind := NewWithResolution(time.Hour)
+	defer behind.Stop()
+	atomic.StoreInt64(&behind.cachedTimeNano, before-int64(time.Second))
+	SetDefault(behind)
+
+	if after := UniqueNano(); after <= before {
+		t.Errorf("Global UniqueNano went backwards after SetDefault: before=%d, after=%d", before, after)
+	}
+}
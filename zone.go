@@ -0,0 +1,173 @@
+// zone.go: Time zone aware cached views
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ZonedView exposes the cached time of a TimeCache in a fixed *time.Location.
+//
+// The zone conversion, RFC3339Nano rendering and calendar fields are computed
+// at most once per cache update and shared by all readers, so repeated reads
+// are zero-allocation. Because every update recomputes the conversion with
+// time.Time.In, daylight saving transitions are reflected as soon as the
+// cached instant crosses them. Views are obtained from (*TimeCache).In and
+// are safe for concurrent use.
+type ZonedView struct {
+	// tc is the cache the view reads, or nil for views created by the
+	// package-level In, which follow the current default cache.
+	tc *TimeCache
+
+	loc *time.Location
+	cur atomic.Pointer[zonedSnapshot]
+}
+
+// zonedSnapshot is an immutable, pre-computed view of one cached instant.
+type zonedSnapshot struct {
+	nanos   int64
+	t       time.Time
+	s       string
+	year    int
+	month   time.Month
+	day     int
+	hour    int
+	min     int
+	sec     int
+	weekday time.Weekday
+	yearDay int
+	zone    string
+	offset  int
+}
+
+// newZonedSnapshot computes the snapshot of nanos in loc.
+func newZonedSnapshot(nanos int64, loc *time.Location) *zonedSnapshot {
+	t := time.Unix(0, nanos).In(loc)
+	zs := &zonedSnapshot{
+		nanos:   nanos,
+		t:       t,
+		s:       t.Format(time.RFC3339Nano),
+		weekday: t.Weekday(),
+		yearDay: t.YearDay(),
+	}
+	zs.year, zs.month, zs.day = t.Date()
+	zs.hour, zs.min, zs.sec = t.Clock()
+	zs.zone, zs.offset = t.Zone()
+	return zs
+}
+
+// In returns a new view of the cached time in loc. The cache keeps no
+// reference to the view: create it once, keep it and reuse it, since each
+// view memoizes its own conversion and a fresh view recomputes it on first
+// read. In panics if loc is nil, like time.Time.In.
+//
+// Example:
+//
+//	rome, _ := time.LoadLocation("Europe/Rome")
+//	view := tc.In(rome)
+//	year, month, day := view.Date()
+//	fmt.Printf("%s (%d-%02d-%02d)\n", view.String(), year, month, day)
+func (tc *TimeCache) In(loc *time.Location) *ZonedView {
+	if loc == nil {
+		panic("timecache: In called with nil location")
+	}
+	return &ZonedView{tc: tc, loc: loc}
+}
+
+// UTC returns a view of the cached time in UTC.
+// It is equivalent to In(time.UTC).
+//
+// Example:
+//
+//	fmt.Println(tc.UTC().Time())
+func (tc *TimeCache) UTC() *ZonedView {
+	return tc.In(time.UTC)
+}
+
+// In returns a new view of the default cache in loc.
+// See (*TimeCache).In for details.
+//
+// The view resolves the default cache on every read, so it keeps following
+// the default after SetDefault or ConfigureDefault replace it.
+//
+// Example:
+//
+//	var tokyo = timecache.In(time.FixedZone("JST", 9*60*60))
+//
+//	func stamp() string {
+//		return tokyo.String()
+//	}
+func In(loc *time.Location) *ZonedView {
+	if loc == nil {
+		panic("timecache: In called with nil location")
+	}
+	return &ZonedView{loc: loc}
+}
+
+// snapshot returns the snapshot for the current cached instant,
+// recomputing it only after the cache has been updated.
+func (v *ZonedView) snapshot() *zonedSnapshot {
+	var nanos int64
+	if v.tc != nil {
+		nanos = v.tc.CachedTimeNano()
+	} else {
+		nanos = CachedTimeNano() // view of whichever cache is the default
+	}
+	if zs := v.cur.Load(); zs != nil && zs.nanos == nanos {
+		return zs
+	}
+	zs := newZonedSnapshot(nanos, v.loc)
+	v.cur.Store(zs)
+	return zs
+}
+
+// Location returns the location of this view.
+func (v *ZonedView) Location() *time.Location {
+	return v.loc
+}
+
+// Time returns the cached time in the view's location.
+func (v *ZonedView) Time() time.Time {
+	return v.snapshot().t
+}
+
+// String returns the cached time in the view's location formatted as an
+// RFC3339Nano string, e.g. "2025-01-02T15:04:05.123+01:00".
+func (v *ZonedView) String() string {
+	return v.snapshot().s
+}
+
+// Date returns the year, month and day of the cached time in the view's location.
+func (v *ZonedView) Date() (year int, month time.Month, day int) {
+	zs := v.snapshot()
+	return zs.year, zs.month, zs.day
+}
+
+// Clock returns the hour, minute and second of the cached time in the view's location.
+func (v *ZonedView) Clock() (hour, min, sec int) {
+	zs := v.snapshot()
+	return zs.hour, zs.min, zs.sec
+}
+
+// Weekday returns the day of the week of the cached time in the view's location.
+func (v *ZonedView) Weekday() time.Weekday {
+	return v.snapshot().weekday
+}
+
+// YearDay returns the day of the year of the cached time in the view's location,
+// in the range [1,365] for non-leap years and [1,366] in leap years.
+func (v *ZonedView) YearDay() int {
+	return v.snapshot().yearDay
+}
+
+// Zone returns the abbreviated zone name and its offset in seconds east of UTC
+// in effect at the cached time, e.g. "CET", 3600 or "CEST", 7200.
+func (v *ZonedView) Zone() (name string, offset int) {
+	zs := v.snapshot()
+	return zs.zone, zs.offset
+}
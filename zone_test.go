@@ -0,0 +1,112 @@
+// zone_test.go: Test suite for time zone aware cached views
+//
+// Copyright (c) 2025 AGILira - A. Giordano
+// Series: an AGILira library
+// SPDX-License-Identifier: MPL-2.0
+
+package timecache
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // DST tests must not depend on the host zoneinfo database
+)
+
+func TestZonedView(t *testing.T) {
+	tc := NewWithResolution(time.Hour)
+	defer tc.Stop()
+
+	jst := time.FixedZone("JST", 9*60*60)
+	view := tc.In(jst)
+
+	// Views are owned by the caller; the cache does not retain them
+	if tc.In(jst) == view {
+		t.Error("In returned a shared view")
+	}
+	if view.Location() != jst {
+		t.Errorf("Location mismatch: got %v, want %v", view.Location(), jst)
+	}
+
+	want := time.Unix(0, tc.CachedTimeNano()).In(jst)
+	if !view.Time().Equal(want) || view.Time().Location() != jst {
+		t.Errorf("Time mismatch: got %v, want %v", view.Time(), want)
+	}
+	if got := view.String(); got != want.Format(time.RFC3339Nano) {
+		t.Errorf("String mismatch: got %s, want %s", got, want.Format(time.RFC3339Nano))
+	}
+
+	year, month, day := view.Date()
+	wy, wm, wd := want.Date()
+	if year != wy || month != wm || day != wd {
+		t.Errorf("Date mismatch: got %d-%d-%d, want %d-%d-%d", year, month, day, wy, wm, wd)
+	}
+	if name, offset := view.Zone(); name != "JST" || offset != 9*60*60 {
+		t.Errorf("Zone mismatch: got %s %d", name, offset)
+	}
+	if view.Weekday() != want.Weekday() || view.YearDay() != want.YearDay() {
+		t.Errorf("Calendar fields mismatch: got %v/%d, want %v/%d",
+			view.Weekday(), view.YearDay(), want.Weekday(), want.YearDay())
+	}
+
+	if tc.UTC().Time().Location() != time.UTC {
+		t.Error("UTC view is not in UTC")
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = view.String()
+		_, _, _ = view.Clock()
+	})
+	if allocs != 0 {
+		t.Errorf("ZonedView allocated %v times per call, want 0", allocs)
+	}
+}
+
+func TestZonedSnapshotDST(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatalf("LoadLocation failed: %v", err)
+	}
+
+	// Clocks in Rome jump from 02:00 CET to 03:00 CEST on 2025-03-30 (01:00 UTC)
+	before := newZonedSnapshot(time.Date(2025, 3, 30, 0, 59, 59, 0, time.UTC).UnixNano(), rome)
+	after := newZonedSnapshot(time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC).UnixNano(), rome)
+
+	if before.zone != "CET" || before.offset != 3600 || before.hour != 1 {
+		t.Errorf("Before DST: got %s %d hour=%d", before.zone, before.offset, before.hour)
+	}
+	if after.zone != "CEST" || after.offset != 7200 || after.hour != 3 {
+		t.Errorf("After DST: got %s %d hour=%d", after.zone, after.offset, after.hour)
+	}
+	if want := "2025-03-30T03:00:00+02:00"; after.s != want {
+		t.Errorf("After DST string mismatch: got %s, want %s", after.s, want)
+	}
+}
+
+func TestInNilLocationPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("In(nil) did not panic")
+		}
+	}()
+	In(nil)
+}
+
+func TestPackageInFollowsDefault(t *testing.T) {
+	defer ConfigureDefault()
+
+	view := In(time.UTC)
+	_ = view.Time()
+
+	// The replaced default is stopped; the view must keep moving with the new one
+	if err := ConfigureDefault(WithResolution(time.Millisecond)); err != nil {
+		t.Fatalf("ConfigureDefault returned error: %v", err)
+	}
+	before := view.Time()
+	time.Sleep(20 * time.Millisecond)
+	if after := view.Time(); !after.After(before) {
+		t.Errorf("Package-level view froze after ConfigureDefault: before=%v, after=%v", before, after)
+	}
+	if view.Location() != time.UTC {
+		t.Errorf("Location mismatch: got %v", view.Location())
+	}
+}